@@ -7,7 +7,10 @@ import (
 	"io/ioutil"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/bytedance/godlp/dlpheader"
 )
 
 var (
@@ -180,6 +183,284 @@ func BenchmarkEngine_DeidentifyJSON1m(b *testing.B) {
 	}
 }
 
+func BenchmarkEngine_DeidentifyDense100k(b *testing.B) {
+
+	// high hit density: every line has a phone, an email and an IPv4 address
+	text := dupString("my phone 18612341234, mail abcd@abcd.com, ip 10.1.2.3\n", 2000)
+	eng, err := NewEngine(CallerSys)
+	if err != nil {
+		b.Fatal(err)
+		return
+	}
+	eng.ApplyConfigDefault()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		eng.Deidentify(text)
+	}
+}
+
+// BenchmarkEngine_*Dense100k compare allocations of string, bytes and pooled APIs, run with -benchmem
+// bytes APIs still copy src once, the saving comes from Text of results and pooled result objects
+func BenchmarkEngine_DetectDense100k(b *testing.B) {
+
+	text := dupString("my phone 18612341234, mail abcd@abcd.com, ip 10.1.2.3\n", 2000)
+	eng, err := NewEngine(CallerSys)
+	if err != nil {
+		b.Fatal(err)
+		return
+	}
+	eng.ApplyConfigDefault()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		eng.Detect(text)
+	}
+}
+
+func BenchmarkEngine_DetectBytesDense100k(b *testing.B) {
+
+	src := []byte(dupString("my phone 18612341234, mail abcd@abcd.com, ip 10.1.2.3\n", 2000))
+	eng, err := NewEngine(CallerSys)
+	if err != nil {
+		b.Fatal(err)
+		return
+	}
+	eng.ApplyConfigDefault()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		eng.DetectBytes(src)
+	}
+}
+
+func BenchmarkEngine_DetectBytesPooledDense100k(b *testing.B) {
+
+	src := []byte(dupString("my phone 18612341234, mail abcd@abcd.com, ip 10.1.2.3\n", 2000))
+	eng, err := NewEngine(CallerSys)
+	if err != nil {
+		b.Fatal(err)
+		return
+	}
+	eng.ApplyConfigDefault()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if rs, err := eng.DetectBytesPooled(src); err == nil {
+			rs.Release()
+		}
+	}
+}
+
+func BenchmarkEngine_DeidentifyBytesDense100k(b *testing.B) {
+
+	src := []byte(dupString("my phone 18612341234, mail abcd@abcd.com, ip 10.1.2.3\n", 2000))
+	eng, err := NewEngine(CallerSys)
+	if err != nil {
+		b.Fatal(err)
+		return
+	}
+	eng.ApplyConfigDefault()
+	dst := make([]byte, 0, len(src))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst, _, _ = eng.DeidentifyBytes(dst[:0], src)
+	}
+}
+
+func BenchmarkEngine_DeidentifyBytesPooledDense100k(b *testing.B) {
+
+	src := []byte(dupString("my phone 18612341234, mail abcd@abcd.com, ip 10.1.2.3\n", 2000))
+	eng, err := NewEngine(CallerSys)
+	if err != nil {
+		b.Fatal(err)
+		return
+	}
+	eng.ApplyConfigDefault()
+	dst := make([]byte, 0, len(src))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var rs *dlpheader.ResultSet
+		if dst, rs, err = eng.DeidentifyBytesPooled(dst[:0], src); err == nil {
+			rs.Release()
+		}
+	}
+}
+
+func BenchmarkEngine_DeidentifyCached1k(b *testing.B) {
+
+	// 100 distinct log lines repeated, such as health checks and templated messages
+	lines := make([]string, 100)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("GET /health?id=%d phone 186%08d mail user%d@abcd.com", i, i, i)
+	}
+	eng, err := NewEngine(CallerSys)
+	if err != nil {
+		b.Fatal(err)
+		return
+	}
+	eng.ApplyConfig(strings.Replace(DEF_CFG, "ResultCacheSize: 0", "ResultCacheSize: 1000", 1))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		eng.Deidentify(lines[i%len(lines)])
+	}
+}
+
+// BenchmarkEngine_DeidentifyBatch1k runs 1000 messages in a batch, compare with BenchmarkEngine_DeidentifySerial1k
+// TestMain sets GOMAXPROCS to 1, run with -cpu N to use N workers
+func BenchmarkEngine_DeidentifyBatch1k(b *testing.B) {
+
+	inputList := make([]string, 1000)
+	for i := range inputList {
+		inputList[i] = fmt.Sprintf("user %d phone 186%08d mail user%d@abcd.com ip 10.1.2.%d", i, i, i, i%256)
+	}
+	eng, err := NewEngine(CallerSys)
+	if err != nil {
+		b.Fatal(err)
+		return
+	}
+	eng.ApplyConfigDefault()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		eng.DeidentifyBatch(inputList)
+	}
+}
+
+func BenchmarkEngine_DeidentifySerial1k(b *testing.B) {
+
+	inputList := make([]string, 1000)
+	for i := range inputList {
+		inputList[i] = fmt.Sprintf("user %d phone 186%08d mail user%d@abcd.com ip 10.1.2.%d", i, i, i, i%256)
+	}
+	eng, err := NewEngine(CallerSys)
+	if err != nil {
+		b.Fatal(err)
+		return
+	}
+	eng.ApplyConfigDefault()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, in := range inputList {
+			eng.Deidentify(in)
+		}
+	}
+}
+
+func BenchmarkEngine_MergeResults10k(b *testing.B) {
+	// 10k results, each one overlaps with its neighbours and contains a shorter one
+	list := make([]*dlpheader.DetectResult, 0, 10000)
+	for i := 0; i < 5000; i++ {
+		list = append(list, &dlpheader.DetectResult{RuleID: 1, Level: "L3", ByteStart: i * 10, ByteEnd: i*10 + 15})
+		list = append(list, &dlpheader.DetectResult{RuleID: 2, Level: "L4", ByteStart: i*10 + 2, ByteEnd: i*10 + 8})
+	}
+	for _, policy := range []string{OVERLAP_POLICY_DEFAULT, OVERLAP_POLICY_LEVEL, OVERLAP_POLICY_SCORE, OVERLAP_POLICY_LONGEST} {
+		b.Run(policy, func(b *testing.B) {
+			eng, err := NewEngine(CallerSys)
+			if err != nil {
+				b.Fatal(err)
+				return
+			}
+			eng.ApplyConfig(strings.Replace(DEF_CFG, "OverlapPolicy: DEFAULT", "OverlapPolicy: "+policy, 1))
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				input := make([]*dlpheader.DetectResult, len(list))
+				copy(input, list)
+				eng.(*Engine).mergeResults(input, nil)
+			}
+		})
+	}
+	// 10k results which do not overlap, longer ones come later, so that LONGEST keeps them from the tail
+	disjoint := make([]*dlpheader.DetectResult, 0, 10000)
+	for i, st := 0, 0; i < 10000; i++ {
+		disjoint = append(disjoint, &dlpheader.DetectResult{RuleID: 1, Level: "L3", ByteStart: st, ByteEnd: st + i + 1})
+		st += i + 1
+	}
+	b.Run("LONGEST_DISJOINT", func(b *testing.B) {
+		eng, err := NewEngine(CallerSys)
+		if err != nil {
+			b.Fatal(err)
+			return
+		}
+		eng.ApplyConfig(strings.Replace(DEF_CFG, "OverlapPolicy: DEFAULT", "OverlapPolicy: "+OVERLAP_POLICY_LONGEST, 1))
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			input := make([]*dlpheader.DetectResult, len(disjoint))
+			copy(input, disjoint)
+			eng.(*Engine).mergeResults(input, nil)
+		}
+	})
+}
+
+func BenchmarkEngine_Classify1k(b *testing.B) {
+	text := Read("./testcases/test_1k.txt")
+	eng, err := NewEngine(CallerSys)
+	if err != nil {
+		b.Fatal(err)
+		return
+	}
+	eng.ApplyConfigDefault()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		eng.Classify(text)
+	}
+}
+
+func BenchmarkEngine_ContainsSensitive1k(b *testing.B) {
+	text := Read("./testcases/test_1k.txt")
+	eng, err := NewEngine(CallerSys)
+	if err != nil {
+		b.Fatal(err)
+		return
+	}
+	eng.ApplyConfigDefault()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		eng.ContainsSensitive(text, "L3")
+	}
+}
+
+func BenchmarkEngine_DeidentifyJSONRecords1k(b *testing.B) {
+
+	// 1000 records, compare with BenchmarkEngine_DeidentifyJSONStreamRecords1k by -benchmem
+	text := "[" + dupString(`{"uid":10086,"name":"abc","phone":"18612341234","list":[1,2,3]},`, 1000) + "{}]"
+	eng, err := NewEngine(CallerSys)
+	if err != nil {
+		b.Fatal(err)
+		return
+	}
+	eng.ApplyConfigDefault()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		eng.DeidentifyJSON(text)
+	}
+}
+
+func BenchmarkEngine_DeidentifyJSONStreamRecords1k(b *testing.B) {
+
+	text := dupString(`{"uid":10086,"name":"abc","phone":"18612341234","list":[1,2,3]}`+"\n", 1000)
+	eng, err := NewEngine(CallerSys)
+	if err != nil {
+		b.Fatal(err)
+		return
+	}
+	eng.ApplyConfigDefault()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		eng.DeidentifyJSONStream(bytes.NewReader([]byte(text)), ioutil.Discard)
+	}
+}
+
 func check(e error) {
 	if e != nil {
 		fmt.Println(e.Error())
@@ -1,6 +1,6 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// conf.yml (20.327kB)
+// conf.yml (27.183kB)
 
 package dlp
 
@@ -69,7 +69,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _confYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3c\xfd\x77\xdb\xc6\x91\xbf\xeb\xaf\xd8\x07\xbd\xeb\x23\x53\x50\x47\x90\xd4\x87\xf9\xd2\xaa\x34\x49\xcb\x3c\xeb\xeb\x48\xc9\xbd\x96\xe2\xf1\x2d\x81\x25\x85\x13\x09\xf0\x00\x50\x96\x22\xf0\x5e\x94\x26\xb1\xd3\xca\x76\xda\xc8\xcd\x35\xb1\xeb\xb8\x97\xd8\x49\x5a\x5b\x76\xd3\xfa\x43\x72\xec\x7f\x46\x20\xa5\x9f\xfc\x2f\xdc\xdb\x5d\x00\x04\x40\x50\xa2\x64\xba\xbd\xbb\x77\x79\x2f\xe2\x7e\xcc\xcc\xee\xce\xcc\xce\xce\xcc\x2e\x3c\x0c\xa6\xe6\x52\xd3\xf3\x80\x97\xa5\xb2\x58\x01\x65\xb1\x8a\x86\x86\xc1\x0a\x5a\x57\x01\x54\x10\x58\xac\xd7\x91\x92\x84\x35\x54\x4d\x42\x15\xb1\x00\x4a\x02\x69\x57\x61\x0d\x01\xa8\x82\x54\xb5\x9e\x94\xa5\x32\x50\x35\xa5\xc1\x6b\x40\x94\x08\xa1\x7f\xc4\x7f\x46\x2a\xf2\xd0\x54\x55\x2e\xc1\x6a\x7c\x08\x80\x14\xd4\x50\x1c\x44\xc2\x11\x2e\xc4\x85\x43\x91\xf1\x21\x00\x12\x75\xf1\x22\x52\x54\x51\x96\xe2\x60\x35\x32\x04\xc0\x8c\x2c\xa0\x38\x50\x50\x15\x41\x15\x81\x61\x20\xa0\x52\xa3\xa2\x9b\x75\x8c\x50\xad\xca\x97\xb2\xf3\xc9\x38\x00\x65\x58\x25\x20\x9a\xd2\x40\xa0\x2c\x2b\x40\x41\x35\x59\x43\x40\x45\xca\xaa\xc8\x23\x70\x49\xd4\x96\x81\x52\xe7\x59\x13\x12\x83\xd4\x1b\x0a\x02\x7c\x55\x44\x92\x06\x72\xa9\x0b\x2c\x10\x50\x19\x36\xaa\x1a\x10\x55\x0a\x35\x04\xc0\x30\x10\xcb\x20\x2d\xc1\x52\x15\x65\x1b\x55\xa4\xe2\x3e\x54\xab\x6b\xeb\x2c\x10\x35\x50\x43\x50\x52\x01\xac\x56\x81\x42\x3a\x31\x27\x10\x01\x16\x58\x50\x6a\x68\x5e\x64\x5e\x96\x34\x28\x4a\x2a\x50\xe5\x1a\x22\x38\x99\x94\xca\x02\x59\xaa\xae\x03\x6d\x19\xa9\xa8\x9b\xce\x08\x99\xc4\xc2\x32\x92\x00\x16\xcb\x25\x11\x0f\x86\x6a\xf2\x2a\x72\x11\xc1\x23\x69\xcb\x68\x9d\xa0\x8a\x12\x48\x89\xaa\x3d\x2c\x26\xe1\x98\x45\x1c\xe4\x0b\x84\xa8\x40\x61\x00\x04\x3c\x52\xf0\xbc\x08\x31\x50\x5a\x07\xf5\x86\xba\x6c\x52\xc6\xc4\x04\x07\x31\x2c\x3a\x47\xd5\x24\x36\x03\xd7\xa6\xe5\x4a\x46\xaa\x37\xb4\x38\x88\x85\xcf\x8c\xd1\xb6\x2c\xaa\xa0\xb5\x2c\xa1\x13\x07\xe1\xa1\x19\xa8\xae\x50\x2c\x32\x7e\x7a\x0d\xd6\xea\x55\x04\xac\x66\xa0\x6a\x50\xd1\x86\x00\x08\x01\x5c\x9d\x85\x35\x14\xb7\x80\x92\xe7\x13\x59\x30\x0c\x70\x1b\x90\xcb\x36\xca\x10\x00\x80\x54\x16\xd6\xeb\x28\x0e\x4c\x28\x59\x22\x40\x79\x5c\x65\xc1\x42\x62\x8a\x05\xd9\xf4\xfc\x74\x22\x99\x66\x41\x62\x7a\x6a\x0e\x14\x08\xde\x45\x58\x6d\xa0\x38\x60\xde\x62\x48\x75\xae\x5c\x56\x91\x16\x07\x1c\xa9\xcd\x43\x41\x10\xa5\x4a\x1c\x84\x31\x41\xd2\x05\xca\x8a\x5c\xc3\x5c\x06\x1a\x14\xab\x04\x6a\x1a\x49\x15\x6d\x39\x0e\x46\x49\x2d\x8b\x56\x91\xa2\xa2\x38\xd1\x42\xd2\x92\xa9\x48\xb2\x82\x92\xcb\x50\xc9\x61\xd2\xcc\x4f\x18\x47\xf3\x05\x51\x12\xe2\x20\x0f\x66\x17\x67\xd2\xd9\x4c\x12\x14\x1c\x53\x37\xdb\xf0\x7c\xe7\xcf\x27\x8a\x8b\xf3\xf3\xe9\x6c\x31\x99\xc8\xa5\xad\x96\xe9\xb9\x9f\xda\x2d\x3f\x3d\x9f\x59\x48\xe7\xe6\xc9\xfa\xe6\x17\x67\x93\x0b\x8b\x89\x85\xcc\xdc\x6c\xc1\xc3\xca\xc4\xf4\xb4\x0f\xbf\xba\x59\xe1\xc3\xfe\x85\xc4\x94\x07\x95\xb6\xf8\x80\x9a\x9c\xf6\x80\x3b\x5b\xad\xc1\xde\x36\x1b\x53\x3f\xee\x31\x6a\x7a\x66\x7e\xe1\x67\x7d\x10\xea\x81\x7e\x36\x91\x4b\x8f\xc5\x3c\xf8\x58\xfe\x2e\x64\x0a\xc5\x38\x78\x4f\x5b\x58\x30\x93\x1a\x65\x41\x32\x9b\x8c\x46\x58\x90\x48\xa5\xb2\xe9\x5c\x8e\xc5\xc2\x3a\x9b\xce\xb2\x20\x95\xce\xa4\xd2\xb3\x0b\x99\x73\x3f\xf3\xb2\xd9\x1c\x7d\x26\x35\x7a\xdc\xd0\x33\xa9\xd1\x01\x8e\xdb\xe9\x39\x6e\xdc\x0e\x24\xe3\xbf\x11\x91\x24\x78\x88\x33\xb3\x8b\xd3\xd3\x4c\x1f\xb2\xb0\xe0\x9c\xc8\xc9\xf3\x99\xd9\xc4\xfc\xf9\xb9\x59\xaf\x56\xf8\xeb\x5f\x67\x2b\x46\x5d\x9b\x6c\xcc\x77\x4b\x85\xbc\xa3\x9d\x66\xa0\x88\x7b\xcf\x47\xfa\x1b\x89\xac\x2b\x93\x3a\xe1\x58\x1e\xfb\xc2\x79\x88\x66\x52\xc9\x44\x76\xc0\x34\xd3\x33\x89\x4c\x7f\x7b\xdf\x4b\xd2\xd7\x80\x39\x49\x2f\x9e\x6a\xfd\x4e\x0a\x67\x13\xb3\x17\x4e\x48\x22\xe6\x6b\x6e\x5d\x4a\x90\xc8\xe5\xe6\xe7\xb2\x0b\xaf\xaf\x07\x2e\x0b\x4a\x77\xe3\x31\xfb\xab\x03\xe5\xc4\x9d\x4d\xcc\x9c\x46\xfd\x5d\x24\x88\x0d\x38\x66\x74\x1b\xc8\x89\x39\x93\x48\xe2\x59\xf5\x39\x7e\xf7\x39\x66\x6d\xc1\x09\x5f\xa5\x88\x77\xed\x8c\xc4\xd9\xc4\x6b\x8f\xd5\xe7\x76\x3f\x9b\x59\x48\xce\x65\x66\xfb\x1c\xce\x22\x1e\x89\xb9\x38\x3d\xea\xdd\xd9\x89\x7e\x79\xe5\x56\xc9\x9e\x9a\x93\x3a\xf1\x36\xf1\x10\x8c\xf5\xcb\x8d\xec\xc2\xf9\xd7\x66\x7d\x9f\xf6\x2f\x31\xd5\xaf\x46\xf7\x1e\xaa\xcb\x54\xa5\x16\xfb\xa4\x69\xb3\x66\xcc\x43\x82\x04\x30\xfd\xf8\x1f\x34\xd2\x49\xca\xf5\x75\x45\xac\x2c\x6b\x24\x1a\xf9\x31\x33\x34\x0c\xb2\xb6\x17\xce\xcb\xb5\x92\x28\x21\x81\x06\x10\x66\x80\x60\x76\x4b\x02\xa8\x2b\xe2\x2a\xd4\x4c\x37\x1b\x7b\xe9\x76\x0c\xd1\x71\xe4\x6b\x50\x82\x15\x24\x60\xbf\x1a\x0f\xa7\x21\x58\x63\x81\x20\x03\x49\xd6\x40\x4d\x16\xc4\xf2\x3a\x46\x6a\x40\x8b\xae\x20\x2a\x88\xd7\xaa\xeb\x6c\x2f\x07\x5d\xc3\xc1\x00\x2f\xd7\xd7\xc9\x14\x4c\x12\x22\x09\xb6\x9c\xf3\x19\x1a\x06\xe1\xb7\xdd\x53\xa6\x2e\xfd\xdb\x5c\x38\x1c\x0e\x03\x16\x63\x93\xe2\xdb\x3f\xb2\x10\x4d\x88\xa1\x61\x50\x51\x10\xd4\x90\x02\xa8\xf7\x4e\xe3\x0e\x79\x15\x29\x97\x14\x11\x87\x55\x35\x58\xad\x76\x7a\x15\xa4\x92\xb8\xa9\x0c\x04\xa4\x21\x5e\x03\x75\x59\x15\x35\x51\x96\x70\xb8\x84\x1d\x66\x1c\x1e\x0e\x39\x5c\x7f\xc7\xac\x3c\x5e\x3f\x8e\x14\xcc\xa3\x47\x2a\xcb\x54\x78\x9d\x13\x3d\x85\x54\x5e\x11\xeb\x1a\x89\x11\x5b\x1f\xfd\xaa\x75\x73\xd7\xb8\xfe\x84\x74\xa5\x25\x2a\x7d\x0d\x55\x51\x7d\x59\x96\x50\x51\x6a\xd4\x4a\x48\x21\x9d\x49\xb3\xb3\xbd\xfd\xd7\x83\x9d\x5b\xc6\xf5\x27\xed\x2f\x36\x4d\x35\x5c\x45\xd5\x38\x98\xa6\xdb\x6b\x18\xa4\xe8\xfc\xcb\x48\xac\x0a\x78\xf2\x50\x02\x50\x51\xe0\x3a\x09\x18\xcd\xc5\xd5\x90\xb6\x2c\x0b\x2a\x4b\x56\xa6\xa0\x2a\x24\x2b\x95\xcb\x00\x41\x7e\x19\x88\x1a\xaa\x91\xa0\x89\x02\x8b\x2a\x98\xcb\xda\x50\x23\xe6\x30\x24\x30\xc2\xa1\x6d\x05\xad\x01\xb4\x56\x57\x90\x8a\xe3\x5e\x16\x48\x32\x90\x10\x12\x80\x26\x03\xa4\xf2\xb0\x8e\x4c\x84\x0b\x59\x54\x61\x2f\xe2\x3f\x17\x52\x22\xaf\xb1\x17\xf1\x5f\x6b\xce\x22\xaf\xe1\x38\xe2\x92\xac\x08\x1c\x4b\x7e\x22\x2c\x18\x19\x19\x29\xb0\x26\x44\x00\xa3\x03\x5d\x07\x04\x39\x08\x7e\xf0\x03\x10\xb8\x68\x36\x11\x4a\x41\x93\xbb\x78\xca\x71\x52\x06\x64\x48\x33\xbc\x23\x55\x73\x18\xab\x8e\xf1\x2d\x50\x2c\x3c\x2e\x30\x19\x0f\x04\x02\xd1\x7c\x38\x74\xa6\x10\xd4\x03\xb1\xfc\x28\x2d\x8c\xe6\xc3\xa1\xa8\x59\x1e\xcb\x47\xd8\xd1\xd0\x38\x2e\x8e\xe7\xc3\x5c\x74\x34\x34\x81\xcb\x13\x16\xd2\x19\x1b\x36\x98\x07\xa1\xc2\xe4\x92\xb0\x11\x6b\x76\x4a\x41\x3d\x10\x18\x8f\xd1\xae\x7c\x38\x34\x5a\x58\x12\x36\xa2\xce\xfe\xe0\x52\xc9\x9a\x9e\x6b\xba\xc3\xe0\x9c\x58\xc5\xea\x6c\x47\xe0\xa5\x2a\xe4\x57\xaa\xa2\xaa\x51\x91\xd0\x6e\x6b\x3d\x67\x13\xd5\x8a\x1c\x07\xf9\x99\x44\xee\x42\x3a\x85\xe3\x32\xb5\x51\xaf\xcb\x8a\xa6\x02\xda\xc4\x76\x74\x1d\x09\x60\x15\x1b\x94\x0e\xe9\xb7\x2c\xcd\x20\x9b\x82\xec\x1c\xbc\xd7\x4b\xb8\x49\x6b\x28\x12\x12\xac\x61\xac\x39\xd2\x34\x83\x19\x07\x50\x3c\x15\xeb\x90\x3d\x49\x20\x60\xa1\x1f\x47\x76\xc4\xa2\x6b\x4a\x0e\x0c\x3b\x28\x50\x5d\xc3\x45\x93\x23\x49\x59\xd2\xd0\x1a\x1e\xdf\xa9\x38\x05\x6b\x1a\x3c\xed\x26\xad\x2a\x58\x86\x2a\xd6\xc9\x12\xc2\xdb\x41\x6e\x48\x82\x7b\x2e\xda\xb2\x28\x59\x04\x2f\x22\x45\x2c\xaf\x67\xa1\x54\xa1\xca\x4b\xeb\x16\x6b\x93\xe6\x9a\x19\xc2\x2e\x5e\x2b\x92\xbd\xca\xb0\x80\x51\x50\x0d\x2a\x2b\xc5\x9a\x5c\x12\xab\x48\x65\x58\x26\x49\x21\xe6\x2d\x00\x13\x92\xfe\x62\x00\xba\xc1\x19\x96\xb1\xf7\xbc\xb3\x8c\x21\x78\x54\xad\x32\x2c\x43\x69\x32\x2c\x23\x97\xcb\x22\x8f\x0b\x3c\x24\x1d\xb8\xdf\x42\xb4\xcb\x18\x51\xad\x41\x45\xb3\x7a\x3a\x15\x73\x54\xfc\x57\xa6\x63\x31\x2c\x53\x15\xa5\x15\x5a\x32\xd7\xd4\x29\x89\x52\x59\xb6\x66\x2c\x75\x4a\x84\x82\x55\xf4\x2c\xa1\x68\x51\x46\x1d\xac\x4e\x8d\x60\x3a\xab\x14\x9b\x2e\xb0\x83\x50\x93\x4b\x55\xd1\x89\xe2\x04\x70\x23\x39\xe0\x5d\xb0\x1e\x30\x5e\x16\x30\x2b\x6c\x9b\xcb\xb0\xcc\xfe\xf3\x2f\xda\x37\x6f\xdb\x8d\x0c\xcb\x50\xc3\xca\xb0\xcc\xc1\xe6\x76\xfb\xbb\x3d\x86\x65\xa8\xc1\x65\x80\x65\x35\x92\x96\x6a\x5a\x46\x90\x68\x24\xb1\xae\xa6\xc2\x59\xfb\xd7\xdc\x82\x58\x89\xe9\xfe\x22\x1a\x5f\x42\x60\x15\xeb\x93\x48\x0f\x54\x52\x5e\x07\xe5\x86\xc4\x6b\xc4\x7e\xaa\x0d\x7e\x19\x40\xd5\x8c\xa2\x58\x70\xb0\xfb\xc7\xfd\xbd\xef\x0f\x76\x36\x5b\x5f\xdc\x39\xfc\x76\xcb\xb8\xfc\x7d\xeb\xc6\x43\xdb\x37\x70\x86\xa6\x60\xd8\x8e\x7f\xd5\x11\xcb\x99\xa0\x27\xcb\x9a\x86\xcf\xa2\x38\x18\x06\x68\x4d\x53\x20\xc0\x52\x55\x6a\x90\x8e\xb8\xb2\x0a\x68\x0d\x99\x33\x4f\x4b\x53\x8a\xdc\xa8\xc7\x41\x43\x45\x4a\x51\x80\x1a\xb4\xd6\x6e\x75\xb4\xb7\xbf\x6e\x5d\x79\xd2\xba\xf1\xb0\x75\xf5\x81\xeb\xe0\x8b\x78\x0e\xbe\x4e\xdc\xe6\x3a\xf8\xda\xdb\x7f\x35\xee\x7f\x7c\xf8\xde\x83\xfd\xbd\xc7\xc6\xcd\x87\xc6\xad\x77\x5d\x27\x20\xc1\x2a\x42\x41\xc0\xa7\x8a\xf7\xf8\xa3\x88\xed\x07\x8f\x48\x07\x99\x10\xed\x73\xcf\xd6\x7d\x2e\xba\x0f\x06\xaf\xe5\x5f\x2a\x05\x02\x81\x40\xfe\xad\x1f\x2e\x85\x7e\x34\xf9\xaf\xc5\x0d\xbd\xf9\x1f\x4b\x97\xb0\x35\xf7\x36\xb9\xeb\x23\x4b\x97\x0a\x1b\x61\xb6\xd9\x85\x18\xcc\xff\xa4\xb0\x74\xe9\x87\x81\x7c\x68\x04\xff\x06\xdf\x5a\x1a\xc9\x27\x42\x3f\x87\xa1\x77\x0a\x1b\x11\x76\xa2\x63\xe5\x8f\x34\xda\x3e\xb6\xc7\xd2\xa9\xd4\xdc\x4c\x22\x33\x5b\x70\xa8\x41\x87\xd1\x96\xb0\x5f\x5f\x98\x31\x8f\x30\x89\xaa\x15\x1d\xe1\xbd\x4b\xa6\xfb\x4f\xef\x1b\x9f\x7f\x6f\xab\xeb\xab\xe7\x5b\xc6\xf5\x6f\x5b\xdb\x3b\xad\xad\x4d\x6e\x62\xff\xfb\xab\xaf\x9e\x6f\x1d\xde\x7c\xf7\xe0\xee\xe6\xe1\xbb\x9f\x1d\xbc\xbc\x4c\xd5\xb9\xfd\xe0\xd3\xd6\x77\x37\x5c\xc2\xe7\x97\x45\x09\x16\x45\xa1\xc8\x43\x45\x70\x09\xdf\x33\xc2\xa9\xc4\xcc\xe5\xb9\xd0\x68\x41\x8f\xe4\xb9\x50\xb4\xa0\x47\xf3\x5c\x68\xbc\xa0\xc7\xf2\x5c\x68\xac\xa0\xe3\x73\x3e\x56\xd0\xc7\x28\x48\x7e\x3c\x74\xa6\xc0\x05\xc9\xb1\x1c\xe0\x26\x74\xee\x8c\x1e\x09\xe3\x6a\xa4\x19\x08\x84\xf3\x1c\x3d\xef\xb9\x7c\x38\x14\x29\x04\x83\x81\x00\x29\x98\xcd\x5c\x58\x8f\x84\xf5\x68\x58\x8f\x12\x02\xd1\x26\x76\x0f\xfe\x65\xad\x60\xc9\xdd\x5f\xaa\x94\xb3\x05\xef\xe6\x36\x03\xaf\xc1\xc9\x75\xd4\x23\xd7\x54\xfa\x6c\x66\xa1\xe8\x2f\x55\xe3\xdd\xdb\x07\x0f\x1e\x1a\x57\xef\x18\xd7\x9f\xb0\x87\x9f\x3c\x3e\xd8\xdc\x76\x49\x4b\x40\x25\x51\x23\xa2\x2a\x42\x9e\x97\x1b\x92\xe6\xe7\xb5\x3a\xa9\x0c\x72\xdb\x8e\x45\x96\x84\x0d\x8e\x63\xb9\xf1\xa6\x2f\x67\xed\xb3\x9a\x4c\x93\x9c\x9a\x8a\xc0\xb0\xcc\xaa\xa8\x42\x60\xb5\x35\x24\x51\x96\xea\x70\x1d\x9b\x7f\x6b\x9e\x8c\x53\x0a\x76\x7e\x67\x70\x22\x18\xf3\x6e\xad\x6c\x3a\xd5\x53\x06\xfb\x2f\xef\xb4\xb7\xbf\x76\x70\xcf\xde\x2a\x0a\x12\xfa\xe0\x7e\x17\xfe\x89\x6d\x23\x51\x6b\xa2\xc8\xc1\xfc\x92\x1a\x2a\x04\xa8\xb3\xda\xab\xec\xf0\x62\xdd\x72\x02\x5c\xac\xe9\xe9\xb2\x48\x73\x11\x96\x9b\xf0\x97\xa2\xb5\x3f\x28\x93\x3a\x7b\xa4\x23\x5f\xc0\x50\x56\x30\xac\x4d\xdb\xfc\xcf\x94\x78\x57\x33\xd6\x00\x9f\xe6\x8e\x2e\x74\x75\xd5\xa0\xaa\x21\xa5\x07\x39\x58\x43\x6b\x3e\xcd\x82\xa8\xf2\x38\xe6\xf4\xe9\xfa\x37\xbe\xe4\x8b\x20\x21\x45\xf5\x1d\x1d\xa9\x9a\x22\xfb\xf4\x88\x92\xaa\xc1\x3a\x5c\xaf\x21\xc9\x6f\xfd\xb6\xf0\x19\xb3\xeb\x0d\x6b\xf6\xb8\xef\xa1\xe1\xca\x66\xba\x63\xe0\x5f\x7e\xd9\xfe\xe0\x1e\x4b\x6d\x3b\xad\xb8\x74\xbc\x0e\x55\x15\x07\x2e\x2e\x8d\x76\xc0\x9d\x58\x97\xb9\x7c\x8c\x84\x5d\xe3\x38\x18\xcb\xcf\xeb\x75\x3d\xa7\xab\x9d\x86\x9c\xae\xea\x53\x7a\x45\x4f\xeb\x08\x37\x4e\x90\xc6\xa9\x8a\xbe\xa0\xe9\x39\x55\x9f\xae\xea\xff\xfc\xef\x7a\x4a\xd0\x13\x50\x3f\x57\xee\x00\x9c\xd7\x97\xf5\x19\xbd\x46\x1a\x58\x2e\xec\xd8\x02\x3d\x0c\x92\xb5\x2c\xec\x46\x9b\xc5\x61\xec\x18\x2b\x70\x95\x38\xe2\x82\xcc\x37\xa8\x44\x99\x92\x2c\xaf\x98\x3f\xa2\x40\x6c\x98\x06\xab\x72\x05\x97\x44\x4d\x7c\x07\x49\xea\xb2\x58\xc7\x4e\x2c\x61\x0a\xf6\x5d\x77\x36\xf7\xf7\x1e\x63\xdf\xf5\xfe\x8b\x83\x9d\x4d\x97\x2d\x73\x09\x62\x70\x52\x9f\xf0\x48\xdd\x99\x67\xf6\x7a\x09\xad\xdf\x5e\xf6\xf1\xf9\x4c\x6f\xaf\xc8\x4b\xde\x33\xdf\x0d\x7f\xec\xc9\xc1\xf5\xa1\x08\x81\xc0\xc8\x06\xc7\x8e\x35\x03\xc6\xd6\xae\x7e\x78\xe3\x72\x70\xd2\xac\x1f\x3c\xd9\xd1\x0f\xee\x7c\x1a\xa4\x55\xe3\xfa\x13\xbb\xd0\xfa\xea\xb9\x59\xbe\x7a\xc3\xf8\xe0\x17\x16\xfe\xa3\xf7\x74\xe3\xc1\x97\x7a\xeb\xca\x93\xe0\x64\x50\x37\xc9\x1a\xd7\x5e\x9a\xfd\x87\x37\x2e\xeb\xfb\xcf\xee\x0c\x98\xbe\x77\xda\xc6\xc3\xeb\xc6\xd6\xee\x60\xa7\xfa\x1a\x34\xad\x55\xea\x87\x97\xb7\xbc\x2b\xed\x85\x62\x8d\x4e\xab\xad\x5b\xbf\x36\xdb\xdb\x7b\xef\xeb\xc6\xa3\x9d\x0e\x99\x6e\x1c\x07\x43\x03\xad\x5b\xbf\x76\x80\x53\xec\xeb\x74\x20\x13\xab\x7d\x73\xd3\x5e\xe2\xd3\xf7\x1c\xfc\xc4\x13\x3e\x78\x62\xa3\x1a\x0f\x1e\x1b\x9f\xdf\x77\x2f\x21\x18\xf4\xe8\x50\x84\x1d\x6b\x4e\x06\xda\x37\x37\xf5\x83\xcb\xdf\xb6\xfe\xbc\x67\x6c\xed\x06\xed\xf9\x4d\x06\x8c\xa7\xef\x75\x3a\xac\xd2\x93\xdf\xbb\x40\xae\xbd\xd4\x4d\x69\x92\xf5\x07\x83\x1b\x1c\x1b\x6d\x06\x7c\x58\x89\x7f\x0f\x37\x3f\xd1\xcd\x35\xea\xed\xbd\xf7\x83\x0e\x95\x20\xf2\xd2\x8d\x2f\xef\x19\xd7\xee\xe2\x45\xeb\xc6\xb3\x97\xc6\xcd\xdd\x2e\x8a\x54\x98\x4e\x4c\x22\x51\x67\x43\x47\x44\x84\x50\xeb\xca\x4b\xdc\xbb\x24\xfc\x30\x64\xfe\x1f\x0c\x6e\x84\xd9\x68\xf3\x88\xb3\xda\x69\x73\x9c\xc6\x60\x70\x26\xe7\x8c\xc7\xe4\xd8\xd7\x53\x6e\x7b\xb3\xbb\x6b\x7c\x7c\xd5\x65\x69\x24\x2b\x2c\xb6\x6d\x4c\x07\xe6\x54\x7e\xa9\x95\x58\x64\x5a\xdb\x8f\xf7\xf7\x1e\xef\xef\xee\x32\x47\x05\x6f\x4e\xd6\xd8\x93\x1e\x1c\x5f\xb8\xb0\x87\x31\xce\xab\x33\x17\x6f\x66\x12\x49\x1f\x43\x3c\x93\x48\xfa\x86\xde\x6e\xe8\x63\x19\x15\xed\xeb\x3c\xc6\x71\x11\x0c\x95\x13\xa1\x73\x05\x1c\x57\xc5\xdd\xf5\xe0\xc6\xa8\xd7\x5d\xc4\x61\xd6\x99\x44\xe8\x1c\x0c\x95\x31\x44\x3e\x1e\x2a\x60\x28\x4f\xb3\x75\xf8\x0e\x9b\x12\x00\x24\x37\xc2\x27\x04\x41\xb1\x2e\x2e\x1a\x2a\xb2\xf2\x7d\x56\x12\x66\x1d\x59\x99\xc5\xce\x39\x8d\xb4\x65\x9a\x43\x82\x3c\xc3\x02\xc6\x64\x0c\x2e\x52\x66\xe0\x52\x0d\xf2\xb8\xdd\x51\x34\x41\xec\x56\xde\x75\x06\x3b\x05\x32\x40\xb9\x7b\x2f\x1d\xfa\x3a\x83\xd9\xd6\x17\xcf\x5a\x57\x1f\xac\xa0\xf5\xd6\xad\xaf\x0e\x76\x3e\x34\xae\xfc\xf1\x54\xc7\xf2\xf1\xfb\xc3\x21\x46\x9a\x5c\x73\x60\xbf\x49\x3b\xc1\x79\x73\x52\x89\xb3\x89\x62\x76\x6e\x71\x21\x33\x3b\xd5\xcd\x9b\xc4\xd9\x04\x30\x3b\x01\x4d\x06\xbe\x7a\xbe\xd5\xda\xde\x69\x7f\xf5\xb5\xb1\x7b\xe3\xf0\x17\x5f\xb7\x3f\x7b\xbf\xfd\xfc\xb7\xd6\xed\x8b\xc5\xa6\x12\x94\x56\x8a\x25\x05\x4a\xfc\x72\x91\x97\x05\xb7\x7d\xc1\x61\xf3\x9d\x2d\xe3\xca\x87\xad\xed\x9d\xd6\xcd\xdd\xd6\xef\xdf\x3f\x65\x20\x96\x0f\x73\x91\xe8\xd8\xf8\x84\xe9\x77\x76\x6f\x8d\x4e\x77\xb4\x19\x22\xb1\x58\x68\x49\x38\x3a\xe9\xc0\x24\xce\x26\xcc\x05\x33\xdd\x61\x95\x77\x5d\xd8\xcd\xdc\xde\x39\xb8\xb3\xb5\xbf\xf7\x5f\x34\x51\x0a\x4b\x90\x61\x19\x45\x6e\x68\xa2\x54\x71\xa9\xb9\x75\x85\x3e\x40\x51\x46\x7d\x83\x8b\x54\x36\x73\x31\x9d\x2d\x4e\x67\x92\xe9\xd9\x9c\xdf\x19\x40\x62\x8b\xc3\x6f\x5e\xb4\x3f\xb8\xf7\xea\xf9\xd6\xc1\xbd\xf7\x8d\x2b\xbf\x6b\x6d\x7d\x64\x67\x92\x0e\xbf\xdd\xb2\xf2\x49\x76\x5e\x43\x11\x57\x45\xa9\x52\xac\x8a\x3c\x92\x54\xe4\x55\x7e\x9b\xde\xa9\x62\x90\xff\x89\x49\xa8\x4e\xaa\x44\x11\x49\xc4\x0a\x18\x73\xed\xb8\x68\xb2\x03\x17\x0f\xbf\x79\x71\xf8\xcd\x63\x1c\x57\xd0\x0a\x8e\x39\x0a\x1e\xa5\xfa\x5b\x65\xb2\x38\x6f\x8a\xd2\xf9\x96\xc2\x1d\x66\xee\x6c\xb7\x3f\x7a\x66\x3c\xdd\x3c\xfc\xcd\x23\x63\xeb\x03\x9f\x73\xaf\x24\x6a\xbc\x2c\xba\xcd\x5c\x6f\xac\x93\x89\x3c\xcf\x45\x0b\x79\x18\x5a\xa9\x85\xde\x49\x84\xce\xff\x53\x68\x76\x3e\xf4\x73\x2c\xa4\x8d\xc8\x18\x1b\x8d\x1e\x9d\xf5\x30\x97\xe4\x4a\xf6\xba\x1f\xe8\x0d\x90\x9f\x5d\xa9\x41\x92\x68\xee\x66\xa7\x71\xfb\xb6\xd7\xad\x12\xe4\x1a\x14\xa5\x62\x97\x77\xe5\x00\x3d\x49\x88\x46\x73\xf2\x81\x3c\xc4\x2c\xfb\xb9\x79\xbb\xea\xa8\x75\x8a\x4b\xa1\xc2\x46\x98\x9d\x18\x6b\x3a\x61\x83\x4b\x23\x27\x40\x1e\x8f\x1e\x89\xbc\x11\x61\xb9\x48\x73\x69\xc4\x6c\x32\xeb\x1d\x92\xb8\x21\x32\x8a\x83\x7e\xfd\x24\x53\xe6\xc6\x22\x03\x1a\xb6\x47\xb6\xc1\xef\xba\x60\x98\xaa\x10\x18\x36\x05\xe6\xba\x87\xad\x41\x75\x05\x09\x6c\xa9\xa1\x01\xa8\x02\x68\x3f\x8b\xd0\x50\xed\x48\x4d\xab\xe0\x1f\x5f\x55\x3b\xfc\xfc\x43\xe3\xf3\x3f\xf8\xa9\x9a\x37\x05\x9a\x99\xef\x56\xb3\xcc\x3c\xdd\x72\xaf\x9e\x6f\xe1\xed\xf7\xf1\x1f\x57\x63\xc6\x6f\xb6\x56\xc7\x5c\x8a\x97\x99\xf7\xf5\x57\x2d\xdc\x53\x78\xa5\x81\xc0\x64\x3c\x32\x4a\xee\xe7\xf5\x08\xb1\xc7\xe4\x7a\x5f\xcf\x87\x39\x72\x6d\x7f\x86\xd6\x27\xb1\xc0\xfe\x8e\x90\x5d\x0e\xc0\x64\x3c\xb0\xa4\xea\x4b\x09\x7c\x12\x04\x9c\xae\x34\xc7\xc6\x9a\xf1\xe0\xc6\x38\x3b\xde\xf4\x36\xeb\x65\x34\x11\x8e\x07\xe2\xba\xdb\xf9\xc6\x5d\x38\xc8\x8b\x35\x83\xff\x40\xdb\xa9\xc6\x71\x6c\x53\x8f\xc7\x03\xe5\x72\xb9\x1c\x88\x87\x6d\x30\x0e\x93\xc7\x3f\x81\x80\x35\xf5\x00\x9d\xbb\xce\x91\x76\xfa\x3e\xc2\x51\x5c\x1a\x09\x6e\x44\x71\x5c\xda\x27\xbc\xee\xb7\x20\xf2\xf3\xe6\x86\xec\x1a\x31\xe0\xc7\x24\x1c\x33\xf7\x9a\x9e\x37\xa6\xb1\x30\x46\x9b\x3d\x10\xa2\x3d\x10\x62\xbd\x10\x62\x3d\x10\xa2\xbd\x10\x46\x7b\x20\x44\x7a\x21\x8c\x35\xbb\xe0\x7b\x40\x8e\x37\xe3\x41\xbd\x17\x93\xc6\x9b\x7a\x3c\x18\xb4\x74\xf4\x1d\x5b\x7b\x3b\x06\x29\x33\x3f\x10\x63\x74\x8a\x63\xcf\x9b\xb4\x5e\xcc\x1d\x91\xb1\x6e\xbf\xb8\xe6\x9f\xaa\x6e\xa8\x45\xdf\x6c\x75\x17\xc2\x09\x9d\x07\x72\x16\x9c\x39\xe6\x86\x6b\x31\x97\x70\xe4\x92\xff\x8f\xa4\x95\x39\x6f\x5e\x79\x31\x57\x3c\x9b\x98\xbd\x50\x74\x3c\x24\xf6\x91\x0d\x0d\xb8\x0e\xfe\x72\xd7\x7b\x61\xd6\x50\x8b\x24\xa2\x39\xe2\xb2\xac\x07\x85\xd3\x88\x6c\xe2\xf8\x7b\xc9\x86\x8a\xa3\x27\x3c\x27\xcc\xf7\x65\xc4\xe3\x5f\x73\x76\x9d\xd2\x30\x2d\xe2\x16\x15\xae\xe2\x20\x8c\xde\x5d\xbe\xe1\xab\x1c\xce\x9b\x62\x5b\xcc\x15\x33\x0b\x7e\xee\x20\x65\x5a\x7b\xf7\xbb\xf6\xd7\xd7\xf6\x77\x77\x69\x1e\xa1\xeb\x5d\x23\x5c\xab\xc3\x75\xa4\x14\x45\x01\x49\x9a\x58\x16\x79\xf2\x00\xa5\xb7\x10\x7a\xd0\x3b\x69\xb4\x75\x86\x84\x4b\xc1\x40\x60\x9c\x8a\x85\x6b\xea\x13\xf9\x70\x68\x02\x97\xcc\x17\x7b\x11\xbb\x1c\xa3\x10\xc1\x60\xc0\xfb\x1e\xcf\x43\x2e\x1f\x02\x18\xf0\x84\x54\x2d\x2c\x37\xf1\x1e\xca\x21\x4a\x82\xb8\x2a\x0a\x0d\x88\x77\xae\xc5\x3d\x86\x65\x44\x4d\x94\x68\x0b\xd9\xe6\xb4\x51\x83\x6b\x64\x1b\xe3\xbe\x37\xac\x17\x11\x6f\x86\xb1\xc7\xf3\x56\x53\x8a\xde\x17\xab\x8e\xcd\x78\xf4\x53\x57\x7f\xf4\x93\x8a\x9f\x5e\x5b\x2f\xa9\x6f\xd1\x18\x38\xb4\x34\xb2\xa4\x16\x26\xe9\x73\x4b\xdd\xd9\xe6\x29\xc7\xba\x13\x2c\xbd\xa0\x4d\x6a\xc7\x5d\xf9\xfd\xff\xeb\xbf\xff\xad\xaf\xff\x5c\x47\x9d\xad\xec\x03\xdc\x51\xde\xdc\x6d\x6e\xaa\x38\x9b\xcd\x24\x8b\xe7\x7c\x73\x19\xbf\x7d\x68\xfc\xf2\x0b\xe3\xd6\x1d\xf7\x53\x28\x6b\x5f\xa9\x15\xdf\xd7\x53\x3d\xb0\x4e\xba\x9d\x26\xc5\x60\x20\x9f\x5b\x38\x37\x45\xa3\x90\x8d\xf1\x66\x1e\x07\x06\xc7\xd9\xb2\x32\x31\x59\x65\x51\xc2\x67\x99\xa4\x88\xbc\xf9\x33\xec\xe2\xad\xe3\x6d\xd9\x00\x99\xeb\xcd\x1a\x1e\xe9\x41\x38\x4f\x7e\x76\x65\xb5\xfd\x68\xcf\xf8\xfd\xaf\xdc\x89\xa2\x63\x3c\x88\x13\xf9\x0e\xf6\xdd\x11\xa6\x6a\xbe\x4e\x22\x03\x38\xcb\xee\xc1\x18\x96\x31\x33\xca\x57\xef\xd8\x65\x3a\x1c\xd6\x6a\xfb\xbd\x87\xe9\x25\x00\x5f\x4a\xf2\x1b\x3f\x24\xba\x5e\x0f\x26\xb2\x3d\x99\x7e\xf0\xfd\xdd\xf6\x47\x5b\x3d\x39\x6e\x26\x22\x8b\xf5\x2a\xd4\x7c\x8f\x0a\x1b\xbf\x7f\x7e\xbb\x68\xd2\x97\x61\x1d\xfe\x52\x7a\xc4\x36\x12\x28\x0a\xe4\xca\x67\x26\x06\x7d\x7f\x13\xf1\xe6\xde\x8e\x7b\x33\xd3\x93\x5d\x47\x3c\x99\x39\x11\x8b\x1c\xc1\x84\x1d\x04\xb4\x9e\xee\xb4\x5e\x7c\x77\xf8\xee\x67\x58\xe7\x76\x36\x89\xd1\x7c\xd8\x7a\xf6\xa2\xd3\xd2\xeb\xf3\x8a\xbf\xc7\xe3\x9b\x37\x1a\x9c\x44\xbc\x19\x2c\xeb\x13\x39\xb7\x6a\x3f\x78\x61\x7c\x79\x39\x93\xf2\x17\x95\x20\xba\x8d\xb4\x05\x7d\x6c\xd2\xca\x16\x92\x40\xbc\x3e\x01\xad\x8a\x3c\x72\x14\x8b\xb4\xdc\xbb\xd3\xa9\xcc\xa9\x81\x27\xe6\x23\xde\x88\xda\xff\x76\xde\xb8\xf7\x89\xf1\xf1\x55\x7f\xd6\x74\x27\x92\x09\xb0\x8b\x33\x7e\x89\x64\x93\x33\x20\xcf\x60\x0a\x58\x41\x09\x1e\x2e\x7c\x7c\xd5\xb8\xff\x29\x09\xa2\xaa\xa8\x68\xf6\xd2\x25\x60\x80\x37\x7c\x2f\x1f\xf1\xc6\xb2\xe4\x4b\xc7\x54\xe2\x67\x3e\x8e\xf3\xf6\xed\xd6\xa7\x5f\xf5\x38\x7d\x44\x45\x5b\x16\xe0\xba\xdb\x55\x26\x08\x27\x60\x8d\x45\x85\x30\x00\xe3\xe2\x03\x02\xb7\xe1\x2d\xfe\x9f\xb7\x8d\xdd\x7b\xee\xf3\xc1\xfe\x28\x73\x80\xfc\xf0\x46\x97\xd6\xc7\x98\x6e\x0d\x79\xf6\x97\xc3\x17\xef\xfb\xb3\x02\x56\x3c\x0a\x42\x60\xfb\xe5\x42\x9e\x81\x15\xa2\x1e\x04\x0b\x17\xfe\xbc\xd9\xba\xf1\xf0\x38\xfb\x15\x0e\x8d\x16\x82\x3a\xbd\x62\x9b\xec\x5c\xae\x9a\x57\x9e\x53\x83\xd6\x9a\xa8\x37\xd6\x4a\xa7\x16\x93\xe4\x1f\xc7\xf0\xe1\xd5\xfd\xbb\xc6\xb5\x0f\xfd\x79\x85\x84\x86\x19\x6e\xa3\xb5\x3a\x52\x44\x24\xf1\x1e\xe6\x11\xe4\xfe\xed\x8e\x4d\x10\x73\x8e\xe0\x32\x6c\xa7\x11\x56\x41\x09\xf2\x2b\x15\xf2\xa5\x15\x85\x38\xfc\xdd\x1f\xb0\x7b\xf2\xf4\x93\xfd\xa7\x9f\xb9\x94\xcb\xfa\x60\x76\x80\x4c\xf3\xba\xd3\xb3\x84\x63\x89\xe9\xcc\x82\xcf\x6e\xc3\x41\xe6\xa3\x9e\x46\x88\xae\x46\xd4\xdc\x1b\x8e\xe2\xf4\xaf\x6a\x0e\x3a\x98\x1b\x04\x9b\xf1\xb9\xd2\xb3\xfe\x99\x92\x01\xf2\xc2\xfb\xfc\x21\x97\xf3\x4b\xe0\x7c\xf9\x62\xff\xf9\x67\xfb\x2f\x6f\x1d\xfe\xee\x1b\xe3\xea\x1d\x7f\x66\xa8\x32\x2f\xc2\x6a\x51\x94\xd4\x86\x02\x25\x1e\x75\xc7\x18\x1e\x32\xfd\xbb\x1a\x18\xf1\xe5\x2d\xea\xc1\xe6\x72\xb3\xd8\x46\xcb\x0d\x3c\x1a\x50\x11\xdf\x50\x44\x6d\x1d\xd8\xce\x99\x6b\x90\xeb\x4f\xde\x7c\x00\x11\xf5\x06\x10\xd3\x73\xbd\xb6\x60\x7b\xef\x7a\x7b\xf7\x4f\xc6\xee\xdd\xfd\x97\x77\x5a\x9b\x3b\x3d\xdc\x59\xa8\x89\x5a\x43\x40\x45\x28\x09\xc5\xaa\x2c\x55\x68\xcd\xf1\x51\x96\x9b\xa9\x6e\x9a\xfd\x2b\x9d\x35\x0e\xf6\x63\xad\x51\x70\x19\x62\x8f\xae\x2a\x55\x30\x2f\xf7\xae\x1b\xbb\x77\xc9\xbe\xbc\xd9\xde\xbb\x8e\x5d\xdf\xaf\x5e\xd2\x02\x1d\x13\x6b\xeb\xd5\x4f\xdb\xbb\x7f\xc2\x85\x2d\x52\xf8\xdb\xa8\xad\x37\x7c\xe8\x7c\x13\xef\x62\x78\xa7\xd9\xe2\x6e\xa7\x25\xd9\xd5\x72\x64\xb0\x6b\xb1\x2d\x16\x8d\x8d\xf3\x42\x2c\x3c\xc1\x73\x25\x21\x1a\x1d\x83\x13\x61\x6e\x62\x6c\x5c\x88\x86\x21\xe4\xd1\x58\x98\x61\x99\x58\x79\x7c\x3c\x3a\x21\x94\x63\x28\x3a\xca\x9d\x11\x26\xc6\xc2\x28\x36\x3a\x1a\x83\x31\x1e\x46\xc6\x84\xd1\x30\x53\x00\xc3\xa0\x26\x8c\x06\x18\x32\x36\x13\x74\xb0\xcc\x31\xe3\xc1\x71\xab\x2b\x76\xe8\x91\x91\x23\xe9\x8c\x57\xcf\xb7\x7c\xf5\xf2\x75\x3e\x3c\xef\xb5\xaf\x69\xfe\x04\x07\x10\x37\x0e\x76\x3e\xb2\xab\x76\x46\x8b\xe6\xa8\xa8\x4a\x1d\xff\x35\x5c\xaf\x0c\xcc\x9b\xff\xb0\x31\xea\x75\xf6\x17\xfd\x9c\x7d\x8a\x8e\xa9\x03\xd3\xb1\xef\x24\x3b\x91\xe2\xf1\xf5\x3b\xc0\x45\xb3\xe7\x54\x8f\x2a\x2d\x5e\x83\x86\x28\xb0\x26\x35\x27\xb7\x16\x07\xe2\xdc\xbb\xff\xc5\x03\x24\x09\xff\x1d\x00\x00\xff\xff\x1d\x17\x43\xa9\x67\x4f\x00\x00")
+var _confYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x7d\x6b\x77\xdb\xc6\xb5\xe8\x77\xff\x8a\x59\xd0\xbd\x67\x91\x31\xa8\xf0\xa5\x17\x57\x72\x14\x88\xa4\x25\x46\x94\xc4\x43\x52\x76\x53\x8a\xe5\x02\x81\x21\x89\x0a\x04\x58\x00\x94\xcc\x08\xbc\x2b\x6a\x93\x38\x6e\xe5\x28\x27\xb1\xeb\xd6\xb5\x9b\xb8\xc7\x76\xd2\xa6\xb6\x9c\xe6\xd4\x0f\x49\xb1\xff\x8c\x48\x4a\x9f\xf2\x17\xee\x9a\x19\x80\x04\x40\x50\x2f\xcb\x39\xf7\x9e\x75\xbc\x96\x89\x79\xec\xbd\x67\x66\xef\x3d\x7b\x66\xef\x19\x40\x43\x60\x7a\x21\x96\x4c\x01\x4e\x96\x4a\x42\x19\x94\x04\x11\x9e\x1b\x02\xcb\xb0\xa1\x02\x56\x81\x60\xb1\x56\x83\x4a\x94\xad\x42\x31\xca\xaa\x90\x06\xac\xc4\xe3\x72\x95\xad\x42\xc0\xaa\x20\x26\xd6\xa2\xb2\x54\x02\xaa\xa6\xd4\x39\x0d\x08\x12\x26\xf4\x26\xfa\x19\x2e\xcb\xe7\xa6\x45\xb9\xc8\x8a\x91\x73\x00\xc4\x58\x0d\x46\x40\xd0\x1f\x0c\xf8\x02\x7e\x5f\x70\xec\x1c\x00\x4c\x4d\xb8\x08\x15\x55\x90\xa5\x08\x58\x09\x9e\x03\x60\x4e\xe6\x61\x04\x28\x50\x84\xac\x0a\xc1\x10\xe0\x61\xb1\x5e\xd6\x8d\x3c\x42\x10\x45\x79\x35\x9d\x8a\x46\x00\x28\xb1\x22\x06\xd1\x94\x3a\x04\x25\x59\x01\x0a\xac\xca\x1a\x04\x2a\x54\x56\x04\x0e\x82\x55\x41\xab\x00\xa5\xc6\xd1\x06\x24\x02\xa9\xd5\x15\x08\x38\x51\x80\x92\x06\x32\xb1\x59\x1a\xf0\xb0\xc4\xd6\x45\x0d\x08\x2a\x81\x3a\x07\xc0\x10\x10\x4a\x20\x2e\xb1\x45\x11\xa6\xeb\x22\x54\x51\x1d\xac\xd6\xb4\x06\x0d\x04\x0d\x54\x21\x2b\xa9\x80\x15\x45\xa0\xe0\x4a\xc4\x09\x88\x81\x79\x1a\x14\xeb\x9a\x13\x99\x93\x25\x8d\x15\x24\x15\xa8\x72\x15\x62\x9c\x44\x4c\xa5\x81\x2c\x89\x0d\xa0\x55\xa0\x0a\xfb\xe9\x0c\xe3\x4e\x64\x2b\x50\x02\x48\x2c\xab\x02\x6a\x0c\x56\xe5\x15\x68\x23\x82\x5a\xd2\x2a\xb0\x81\x51\x05\x09\xc4\x04\xb5\xdb\x2c\x22\x61\xe9\x45\x04\xe4\xf2\x98\x28\x4f\x60\x00\x0b\x38\xa8\xa0\x7e\x61\x62\xa0\xd8\x00\xb5\xba\x5a\x31\x28\x23\x62\xbc\x85\x18\x12\x9d\xa0\x3a\x89\xcd\xb1\x97\x93\x72\x39\x21\xd5\xea\x5a\x04\x84\xfd\x13\xa3\xa4\x2c\x0d\xcb\xf0\x72\x1a\xd3\x89\x00\x3f\x69\x14\x72\x32\x0f\x7d\xac\xc4\xfb\x54\x8e\x95\xb0\x20\xa0\x84\xca\x78\xa0\xc2\x72\x15\x4a\x9a\x8a\x07\x00\x35\xc8\x69\x1e\x2f\x0d\xfc\x06\x9b\x8d\x5e\xf0\x34\x08\x1a\x25\x8b\xe9\xa4\x67\x8a\xc9\xc4\x47\xc3\x9e\xe1\xe1\x61\xaf\x17\x20\x82\x45\x24\xdc\xba\xc4\x93\x1e\xc4\x70\x73\x31\x58\xd3\x2a\xa4\x07\xa4\x40\xc1\x1d\x07\x43\x40\x96\x20\x90\x4b\x20\x47\xc8\xd0\x88\x24\x0d\x66\xe2\x3f\xa3\xc1\x4c\x76\x2e\x99\xa7\x89\xb0\x2d\x82\x2e\xd6\x05\x51\xf3\x21\xa6\x18\x84\xf0\xa8\x2a\xf2\x2a\xd0\x64\xa0\x40\x55\x16\x57\x20\x90\x57\xa0\x22\xb2\xb5\x1a\xe4\x51\x51\x5d\xd4\x54\xba\xdb\x52\x2c\x7e\x81\x59\x4c\x66\x69\x90\x8c\x5f\x8c\x27\x69\x90\x89\x2e\xa4\xe3\x34\x48\x2e\xcc\x4f\xc7\x33\x59\x1a\x2c\xce\x27\x16\xe6\x69\x30\x1b\x8f\xa7\x0a\x4c\x32\x89\xb8\xbb\x40\xc8\xa5\x64\x51\xe0\x1a\x11\x60\x50\xc0\x0d\xcf\x5e\x04\xf0\xb2\xa6\xb0\x9c\x26\x2b\x2a\xa8\xab\x90\x07\x45\xa8\x0a\x3c\x54\xc1\x72\x64\x05\x4f\xcf\xe5\xb7\x57\x7a\xad\xff\xdb\x62\x3c\xfd\x1e\x0d\xa2\x0b\x0b\xb3\x89\x38\x1a\x29\x13\x8b\xa7\x51\xeb\xd3\x17\xe6\xb2\x34\xc8\x64\xd3\x8b\xd1\xac\x63\xd8\x92\x2c\x41\xa4\x42\xdd\x76\xba\x1a\x84\x86\x5d\x65\xd5\x65\xc8\x83\x77\x33\x0b\xf3\x40\xaa\x57\x8b\x50\x41\x73\x64\x55\x11\x34\x0d\x4a\xa0\xc8\x72\xcb\x44\x9c\x02\x0f\x25\x4d\x28\x35\x10\x20\x6e\x28\x31\x3f\x0d\x64\x85\x0c\x34\xfb\x5e\x2a\x7e\x0e\x60\x22\xf3\x98\xc6\x1c\xab\x2e\x47\x0c\x28\x43\x59\xcb\x82\x06\x56\x2b\x02\x57\x01\x0a\xac\x89\x2c\x07\x55\xb3\x6d\x5c\xa7\xa2\xf1\xd9\x7a\x21\xf5\x68\x83\xaa\xcc\x43\xfb\xa8\x26\x8c\xf6\x50\x4b\x31\x44\x20\x02\x28\x0a\x37\x55\x65\x2f\x03\x28\x69\x8a\x00\x31\xcd\x64\x7a\x11\x70\x2c\x57\x31\xed\x4a\x0d\xb2\x1a\xe4\x81\x80\x94\x1d\x03\x10\x55\xa5\x2d\x63\xa4\x8d\x32\xdc\x1d\x24\x03\xe7\xf0\x9d\x3a\x7d\x0e\x80\x34\x56\x94\x28\x6a\x28\x23\xbc\x0f\xcd\xf9\xb2\x2a\x2b\xcb\x50\xb1\xb4\x33\xc5\x6a\x5c\xc5\xda\x18\x2e\x70\x69\xc5\x00\x34\x9b\x9a\x5e\x98\x63\x7e\x96\x4a\x2f\x44\x33\xe7\x00\xc0\x75\x97\x08\x69\xb3\xa5\x9a\x02\x6b\x8a\xcc\x41\x55\x15\xa4\xb2\x7d\x0a\x26\xe6\x2f\x26\x32\x89\xa9\x64\x1c\xd9\x75\xa1\xa6\x82\xf7\xa1\x22\xfb\x56\x05\x5e\xab\x00\xae\xc2\x2a\x2a\x0d\x54\xb9\xa4\x81\x4a\xa3\x86\x0c\x15\xea\x4b\x51\xe0\x05\x6c\xef\x14\x59\x54\x69\xdc\x40\x74\x61\xfe\xc2\x62\x86\x41\x64\x4a\xb2\xc8\xab\xa0\x54\x17\x45\x42\x84\xc9\x44\x13\x09\x1a\x44\x1b\x8a\x20\x8a\x02\x87\x29\x4c\x2b\x10\x2e\x03\x51\x96\x97\x7d\xac\x28\x2c\x43\x20\x42\x4d\x43\xac\x10\x24\x4d\x36\x31\x5c\xf4\x34\xd5\x1d\x07\xd6\x53\x24\x5f\x62\xaa\x70\x27\xe2\x97\xd9\x6a\x4d\x84\xc0\x2c\x06\xaa\xc6\x2a\xda\x39\x00\x7c\x00\x65\xe7\xd9\x2a\x8c\x98\x40\xd1\x19\x26\x0d\x86\x00\x2a\x43\xfc\x37\x51\xce\x01\x00\x70\x26\xdb\xa8\xc1\x08\x30\xa0\xcc\x19\x86\xb2\x34\xc8\x32\xd3\x34\x48\xc7\x53\x49\x26\x1a\xa7\x01\x93\x9c\x5e\x00\x79\x8c\x77\x91\x15\xeb\x30\x02\xa8\x37\x28\x9c\x5d\x28\x95\x54\xa8\x45\x40\x00\xe7\x52\x2c\xcf\x0b\x52\x39\x02\xfc\x88\x20\xae\x02\x25\x45\xae\x22\xd3\x0e\x34\x56\x10\x31\x54\x12\x4a\x65\x64\xce\x46\x70\x2e\x0d\x57\xa0\xa2\xc2\x08\x5e\xfa\x70\x49\xa2\x2c\xc9\x0a\x8c\x56\x58\x25\x83\x48\x53\xef\x50\x96\xe2\x59\x41\xe2\x23\x20\x07\xe6\x17\xe7\xe2\xe9\x44\x14\x58\x8d\xa0\x51\x86\xfa\x9b\x9a\x61\x0a\x8b\xa9\x54\x3c\x5d\x88\x32\x99\xb8\x59\x92\x5c\xb8\xd4\x2d\xb9\x34\x93\xc8\xc6\x33\x29\x3c\xbe\xd4\xe2\x7c\x34\xbb\xc8\x64\x13\x0b\xf3\x79\x07\x2b\x99\x64\xd2\x85\x5f\xfd\xac\x70\x61\x7f\x96\x99\x76\xa0\x92\x12\x17\x50\x83\xd3\x0e\x70\x6b\xa9\xd9\xd8\x5b\x46\x61\xec\x5f\x07\xb4\x1a\x9f\x4b\x65\xdf\x3b\x06\xa1\x01\xe8\x64\x21\x71\xe0\x23\xf9\xdb\x90\x09\x14\xe5\xb2\x00\xcd\xc5\x46\x68\x10\x4d\x47\x43\x41\x1a\x30\xb1\x58\x3a\x9e\xc9\xd0\x48\x58\x53\xc8\x4c\xc7\xe2\x89\x58\x7c\x3e\x9b\xb8\xf0\x1e\x5e\xa9\x52\x4c\x26\x73\x69\x21\x1d\xcb\xbb\x77\x65\x2e\x36\x72\x54\x3f\xe6\x62\x23\xaf\xab\x13\x3d\xb0\xa3\x3a\xd1\x83\xa4\xdc\xa7\x28\x94\x78\x07\x71\x6a\x7e\x31\x99\xa4\x8e\x21\x25\x13\xce\x8a\x1c\x9d\x49\xcc\x33\xa9\x99\x85\xf9\xf8\xb1\x34\xb3\x37\x49\x43\xb6\xe9\x37\xea\x3a\xd9\x7c\xce\xd6\x4e\xd3\x50\xd0\x6e\x0d\x82\xc7\x6b\x09\x8f\x2b\x11\x3b\x61\x5b\x0e\xcb\x13\x70\x10\x4d\xc4\xa2\x4c\xfa\x8c\x69\xc6\xe7\x98\x44\xf2\x54\x24\x5d\x4d\x9b\x95\xf4\xe2\xa9\xc6\x6f\xa5\x30\xc5\xcc\xcf\x9e\x90\x44\xd8\xd5\x10\xdb\x94\x80\xc9\x64\x52\x0b\xe9\xec\xab\xeb\x81\xcd\xb6\x92\xa9\x79\xc4\xfc\xea\x41\x59\x71\xe7\x99\xb9\xd3\xa8\xbf\x8d\x04\x36\x08\x47\xb4\xde\x05\xb2\x62\xce\x31\x51\xd4\xab\x63\xb6\xdf\xbf\xc2\x99\x53\x70\xdc\x55\x29\x22\x7d\x33\x83\x99\x62\x5e\xb9\xad\x63\x4e\xf7\xa9\x44\x36\xba\x90\x98\x3f\x66\x73\x26\xf1\x60\xd8\xc6\xe9\x11\xe7\xcc\x66\xd2\xa7\x52\xc9\x81\x9a\x13\x4b\xc4\x5e\x8d\x60\xf8\xb8\xdc\x48\x67\x67\x5e\x99\xf5\xc7\xb4\x7f\xcc\x74\xfc\x95\x9b\xea\x33\x55\xb1\xc5\x63\xd2\xec\xb2\x66\xd4\x41\x02\xc7\x53\x8e\xb3\x33\xc1\x80\x20\x2a\xd7\x1a\x8a\x50\xae\x68\x38\x38\xd2\xb7\x4b\xc9\xc4\xa3\xe9\x78\xf6\x14\xc2\xb3\x59\xc9\xde\xb2\x7d\xc4\xf4\xb5\x42\x0e\x81\x74\x37\x3a\xc1\xc9\xd5\xa2\x20\x41\x9e\x04\x56\x8c\xc0\x89\x51\x2d\xf1\xa0\xa6\x08\x2b\xac\x66\x84\x1f\x50\xf4\xc2\x04\xb1\x04\x38\xaa\xac\xc4\x96\x91\xbf\xda\xc0\x91\x0d\x0d\xb2\x55\x1a\xf0\x32\x90\x64\x0d\xb9\x6b\x42\xa9\x81\x90\xea\xac\x49\x97\x17\x14\xc8\x69\x62\x83\x1e\x14\xb8\xd0\x90\xef\xc1\xc9\xb5\x06\xee\x82\x41\x42\xc0\x41\x28\x6b\x7f\xce\x0d\x01\xff\x5b\xf6\x2e\x93\x50\xc7\x5b\x01\xbf\xdf\xef\x07\x34\xc2\xc6\xc9\xb7\xde\x36\x11\x0d\x88\x73\x43\xa0\xac\x20\xf7\x4f\x01\x24\xaa\x41\xe2\x31\xc8\xdd\x47\x0e\x2f\x04\x6a\x95\x15\xc5\x5e\x2d\x71\xfe\x81\x50\x02\x3c\xf6\xa5\x40\x4d\x56\x05\x4d\x90\x25\x20\xa8\xa8\xbb\x38\x6c\x76\xce\xe2\x9d\x58\x7a\xe5\x70\x4c\x12\x31\xa2\x9c\x00\x24\xa4\x92\x4c\x84\xd5\xdb\x5a\xc4\xa0\xca\x29\x42\x4d\xc3\xb1\xb3\xf6\xd5\xdf\xb5\x6f\x6f\xb7\x36\x9f\xe2\xaa\xb8\x44\x64\xae\x41\x11\xd6\x2a\xb2\x04\x0b\xc4\x3f\xc6\x95\x51\xa3\xb2\x73\xfd\x9f\xfb\x5b\x77\x5a\x9b\x4f\x3b\x5f\xad\x1b\xf3\x61\x05\x8a\x11\x90\x24\xf3\x7c\xc8\xf0\x05\x41\x09\x0a\x22\x8f\x3a\xcf\x4a\x80\x55\x14\xb6\x81\x7d\x62\x63\x70\x55\xa8\x55\x64\x5e\xa5\xf1\xc8\x14\x28\xb2\x78\xa4\x72\x09\x40\x96\xab\x00\x41\x83\x55\x1c\x4c\x22\xc0\x82\x0a\x16\xd2\x5d\xa8\x61\xa3\x19\x1c\x30\x8a\x00\x05\x3d\x00\xbc\x5c\x53\x90\x37\x2a\x4b\x34\x90\x64\x20\x41\xc8\x03\x4d\x06\x50\xe5\xd8\x1a\x34\x10\x66\xd3\xb0\x4c\x5f\x44\x3f\xb3\x31\x81\xd3\xe8\x8b\xe8\xd7\xec\xb3\xc0\x69\x11\x90\x43\x2e\x34\x1f\xa0\xf1\x23\x48\x83\xe1\xe1\xe1\x3c\x6d\x40\x78\x10\x3a\xd0\x75\x80\x91\xbd\xe0\x5f\xfe\x05\x78\x2e\x1a\x45\x98\x92\xd7\xe0\x2e\xea\x72\x04\xa7\x01\x6e\xd2\x88\x80\xe0\xac\xd1\x8c\x99\x47\xf8\x26\x28\x00\x3e\x10\xf0\x4c\x46\x3c\x1e\x4f\x28\xe7\xf7\x4d\xe4\xbd\xba\x27\x9c\x1b\x21\x89\x91\x9c\xdf\x17\x32\xd2\xa3\xb9\x20\x3d\xe2\x1b\x43\xc9\xb1\x9c\x3f\x10\x1a\xf1\x8d\xa3\xf4\xb8\x89\x34\xd1\x85\xf5\xe6\x80\x2f\x3f\xb9\xc4\xaf\x85\x9b\xbd\x94\x57\xf7\x78\xc6\xc2\xa4\x2a\xe7\xf7\x8d\xe4\x97\xf8\xb5\x90\xb5\xde\xbb\x54\x34\xbb\x67\xeb\xee\x10\xb8\x20\x88\x48\x9d\xbb\x91\xc9\xa2\xc8\x72\xcb\xa2\xa0\x6a\x44\x24\xa4\xda\x1c\xcf\x14\x23\x96\xe5\x08\xc8\xcd\x31\x99\xd9\x78\x0c\xb9\x8e\x6a\xbd\x56\x93\x15\x4d\x05\xa4\x88\xee\xe9\x3a\xe4\xc1\x0a\xb2\x21\x3d\xd2\x6f\x98\x9a\x81\x27\x05\x9e\x39\x68\xae\x17\x51\x91\x56\x57\x24\xc8\x9b\xcd\x98\x7d\x24\xe1\x57\xc3\x3b\x21\x78\x38\x38\xd8\xed\x24\xe0\x91\xd0\x8f\x22\x3b\x6c\xd2\x35\x24\x07\x86\x2c\x14\x88\xae\xa1\xa4\xc1\x91\xa8\x2c\x69\xf0\x32\x6a\xdf\xaa\x38\x79\xb3\x1b\x1c\xa9\xc6\xa5\x2a\xa8\xb0\x2a\xd2\xc9\x22\x44\xd3\x01\x85\x1d\xed\x7d\xd1\x2a\x82\x64\x12\xbc\x08\x15\xa1\xd4\x48\xb3\x52\x99\x28\x2f\xc9\x9b\xac\x8d\x1a\x63\xa6\x30\xbb\x38\xad\x80\xe7\x2a\x45\x03\x4a\x81\x55\x56\x59\x2e\x54\xe5\xa2\x20\x42\x95\xa2\xa9\x28\x81\x48\x99\x00\x06\x24\x79\x22\x00\x32\xc1\x29\x9a\xea\xce\x79\x6b\x1a\x41\x70\x50\x14\x29\x9a\x22\x34\x29\x9a\x92\x4b\x25\x81\x43\x09\x8e\x15\x45\xa3\xde\x44\xec\xa6\x11\xa2\x5a\x65\x15\xcd\xac\xe9\x65\x8c\x56\xd1\xaf\x4c\xda\xa2\x68\x4a\x14\xa4\x65\x92\x32\xc6\xd4\x4b\x09\x52\x49\x36\x7b\x2c\xf5\x52\xf5\xaa\x25\xe9\x18\x42\x41\x92\xad\x59\x47\xae\x5e\x75\x64\x09\x36\x19\x60\x0f\xa1\x2a\x17\x45\xc1\x8a\x62\x05\xb0\x23\x59\xe0\x6d\xb0\x0e\x30\x14\x04\xa6\x68\xaa\x6b\x73\x29\x9a\xda\xdb\xfd\xaa\x73\xfb\xcb\x6e\x21\x45\x53\xc4\xb0\x52\x34\xb5\xbf\x7e\xbd\xf3\xfd\x0e\x45\x53\xc4\xe0\x52\xc0\xb4\x1a\x51\x53\x35\x4d\x23\x88\x35\x12\x5b\x57\x43\xe1\xcc\xf9\x6b\x4c\x41\xa4\xc4\x64\x7e\x61\x8d\x2f\x42\xb0\x82\xf4\x49\x20\x0b\x2a\x4e\x37\x40\xa9\x2e\x71\x1a\xb6\x9f\x6a\x1d\xc5\x08\x55\xc3\x9d\xa3\xc1\xfe\xf6\xb7\x7b\x3b\x3f\xec\x6f\xad\xb7\xbf\xba\x7b\xf0\xb7\x8d\xd6\x95\x1f\xda\x37\x1e\x77\xf7\x02\x56\x1f\x19\x0c\x75\x1d\x71\x75\xd8\xdc\x42\x90\x95\xe5\xb2\x86\xd6\xa2\x08\x18\x22\x31\x68\x80\xa4\xaa\x54\x59\xd2\xe2\xf2\x0a\x20\x39\x68\xf4\x3c\x2e\x4d\x2b\x72\xbd\x16\x01\x75\x15\x2a\x05\x9e\xd5\x58\x73\xec\x66\x45\xe7\xfa\x37\xed\x4f\x9e\xb6\x6f\x3c\x6e\x5f\x7b\x64\x5b\xf8\x82\x8e\x85\xaf\xe7\x40\xda\x16\xbe\xce\xf5\x7f\xb6\x1e\x7e\x76\xf0\xeb\x47\x7b\x3b\x4f\x5a\xb7\x1f\xb7\xee\x7c\x60\x5b\x01\x31\x56\x81\xe5\x79\xb4\xaa\x38\x97\x3f\x82\xd8\x79\xf4\x1d\xae\xc0\x1d\x22\x75\xf6\xde\xda\xd7\x45\xfb\xc2\xe0\xb4\xfc\x4b\x45\x8f\xc7\xe3\xc9\xbd\x71\x7e\xc9\xf7\xf6\xe4\x2f\x0a\x6b\x7a\xf3\xff\x2c\xad\x22\x6b\xee\x2c\xb2\xe7\x87\x97\x56\xf3\x6b\x7e\xba\xd9\x87\xe8\xcd\xbd\x93\x5f\x5a\x3d\xef\xc9\xf9\x86\xd1\xd3\xfb\xc6\xd2\x70\x8e\xf1\xfd\x9c\xf5\xbd\x9f\x5f\x0b\xd2\xe3\x3d\x2b\x7f\xa8\xd1\x76\xb1\x3d\xa6\x4e\xc5\x16\xe6\x98\xc4\x7c\xde\xa2\x06\x3d\x46\x9b\xc2\x7e\x75\x61\x86\x1d\xc2\xc4\xaa\x56\xb0\xc4\x19\x6c\x32\xdd\x7b\xf6\xb0\xf5\xa7\x1f\xba\xea\xfa\xe3\xee\x46\x6b\xf3\x6f\xed\xeb\x5b\xed\x8d\xf5\xc0\xf8\xde\x0f\xd7\x7e\xdc\xdd\x38\xb8\xfd\xc1\xfe\x83\xf5\x83\x0f\x6e\xed\xbf\xbc\x42\xd4\xb9\xf3\xe8\x66\xfb\xfb\x1b\x36\xe1\x73\x15\x41\x62\x0b\x02\x5f\xe0\x58\x85\xb7\x09\xdf\xd1\xc2\xa9\xc4\x1c\xc8\x05\x7c\x23\x79\x3d\x98\x0b\xf8\x42\x79\x3d\x94\x0b\xf8\xc6\xf2\x7a\x38\x17\xf0\x8d\xe6\x75\xb4\xce\x87\xf3\xfa\x28\x01\xc9\x8d\xf9\x26\xf2\x01\x2f\x5e\x96\x3d\x81\x71\x3d\x30\xa1\x07\xfd\x28\x1b\x6c\x7a\x3c\xfe\x5c\x80\xac\xf7\x81\x9c\xdf\x17\xcc\x7b\xbd\x1e\x0f\x4e\x18\xc5\x01\xbf\x1e\xf4\xeb\x21\xbf\x1e\xc2\x04\x42\x4d\xb4\x3d\xf8\xd9\xe5\xbc\x29\x77\x77\xa9\x12\xce\xe6\x9d\x93\x3b\x11\x3b\x63\xb9\x8e\x38\xe4\x1a\x8b\x4f\x25\xb2\x05\x77\xa9\xb6\x3e\xf8\x72\xff\xd1\xe3\xd6\xb5\xbb\xad\xcd\xa7\xf4\xc1\x17\x4f\xf6\xd7\xaf\xdb\xa4\xc5\xc3\xa2\xa0\x61\x51\x15\x58\x8e\x93\xeb\x92\xe6\xb6\x6b\xb5\x52\x39\xcb\x69\x3b\x1a\x5c\xe2\xd7\x02\x01\x3a\x30\xd6\x5c\x2a\x1e\xb6\x56\xe3\x6e\xe2\x55\x53\xe1\x29\x9a\x5a\x11\x54\x16\x98\x65\x75\x49\x90\xa5\x1a\xdb\x40\xe6\xdf\xec\x27\x65\x95\x42\x37\xd0\x74\x76\x22\x18\x75\x4e\xad\x74\x3c\x36\x50\x06\x7b\x2f\xef\x76\xae\x7f\x63\xe1\x5e\x77\xaa\x28\x90\x3f\x06\xf7\xfb\xf0\x4f\x6c\x1b\xb1\x5a\x63\x45\xf6\xe6\x96\x54\x5f\xde\x43\x36\xab\x83\xd2\x96\x5d\xac\x5d\x4e\x20\x10\x6e\x3a\xaa\x4c\xd2\x81\x20\x1d\x18\x6f\x1e\x3a\x3f\x08\x93\x7a\x73\xa4\x27\x5f\x40\x11\x56\x50\x74\x97\xb6\xf1\xcf\x90\x78\x5f\x31\xd2\x00\x97\xe2\x9e\x2e\xf4\x55\x55\x59\x55\x83\xca\x00\x72\x6c\x15\x5e\x76\x29\xe6\x05\x95\x43\x3e\xa7\x4b\xd5\x2f\xb9\xa2\x2b\x82\x04\x15\xd5\xb5\x75\xa8\x6a\x8a\xec\x52\x23\x48\xaa\xc6\xd6\xd8\x06\x3a\x90\x77\xa9\xee\x0a\x9f\x32\xaa\x5e\xb3\x66\x8f\xb9\x2e\x1a\xb6\xb0\xaa\xdd\x07\xfe\xed\xbd\xce\x47\x5f\xd3\xc4\xb6\x93\x8c\x4d\xc7\x6b\xac\xaa\x22\xc7\xc5\xa6\xd1\x16\xb8\x13\xeb\x72\x20\x17\xc6\x6e\xd7\x18\x72\xc6\x72\x29\xbd\xa6\x67\x74\xb5\x57\x90\xd1\x55\x7d\x5a\x2f\xeb\x71\x1d\xa2\xc2\x71\x5c\x38\x5d\xd6\xb3\x9a\x9e\x51\xf5\xa4\xa8\xff\xdb\xaf\xf4\x18\xaf\x33\xac\x7e\xa1\xd4\x03\x98\xd1\x2b\xfa\x9c\x5e\xc5\x05\x74\xc0\x6f\x99\x02\x03\x0c\x92\x39\x2c\x8a\xee\x26\x87\xd0\xc6\x58\x61\x57\xf0\x46\x9c\x97\xb9\x3a\x91\x28\x55\x94\xe5\x65\xe3\x21\xf0\xd8\x86\x69\xac\x28\x97\x51\x4a\xd0\x84\xf7\xa1\xa4\x56\x84\x1a\xda\xc4\x62\xa6\xa0\xbd\xeb\xd6\xfa\xde\xce\x13\xb4\x77\x7d\xf8\x62\x7f\x6b\xdd\x66\xcb\x6c\x82\x38\x3b\xa9\x8f\x3b\xa4\x6e\x0d\x78\x3b\x77\x09\xed\xdf\x5f\x71\xd9\xf3\x19\xbb\xbd\x02\x27\x39\xd7\x7c\x3b\xfc\x91\x2b\x47\xe0\x18\x8a\xe0\xf1\x0c\xaf\x05\xe8\xd1\xa6\xa7\xb5\xb1\xad\x1f\xdc\xb8\xe2\x9d\x34\xf2\xfb\x4f\xb7\xf4\xfd\xbb\x37\xbd\x24\xdb\xda\x7c\xda\x4d\xb4\xef\xef\x1a\xe9\x6b\x37\x5a\x1f\xfd\xc6\xc4\xff\xee\xd7\x7a\xeb\xd1\x3d\xbd\xfd\xc9\x53\xef\xa4\x57\x37\xc8\xb6\x3e\x7d\x69\xd4\x1f\xdc\xb8\xa2\xef\x3d\xbf\x7b\xc6\xf4\x9d\xdd\x6e\x3d\xde\x6c\x6d\x6c\x9f\x6d\x57\x5f\x81\xa6\x39\x4a\xfd\xe0\xca\x86\x73\xa4\x83\x50\xcc\xd6\x49\xb6\x7d\xe7\xdf\x8d\xf2\xce\xce\x87\x7a\xeb\xbb\xad\x1e\x99\x7e\x1c\x0b\x43\x3d\xed\x3b\xff\x6e\x01\x27\xd8\x9b\xa4\x21\x03\xab\x73\x7b\xbd\x3b\xc4\x67\xbf\xb6\xf0\x13\x75\x78\xff\x69\x17\xb5\xf5\xe8\x49\xeb\x4f\x0f\xed\x43\xf0\x7a\x1d\x3a\x14\xa4\x47\x9b\x93\x9e\xce\xed\x75\x7d\xff\xca\xdf\xda\xff\xd8\x69\x6d\x6c\x7b\xbb\xfd\x9b\xf4\xb4\x9e\xfd\xba\x57\x61\xa6\x9e\xfe\xd9\x06\xf2\xe9\x4b\xdd\x90\x26\x1e\xbf\xd7\xbb\x16\xa0\x43\x4d\x8f\x0b\x2b\xd1\xf3\x60\xfd\x0b\xdd\x18\xa3\xde\xd9\xf9\xd0\x6b\x51\x09\x2c\x2f\xbd\x75\xef\xeb\xd6\xa7\x0f\xd0\xa0\xf5\xd6\xf3\x97\xad\xdb\xdb\x7d\x14\x89\x30\xad\x98\x58\xa2\xd6\x82\x9e\x88\x30\xa1\xf6\x27\x2f\x51\xed\x12\x7f\xde\x67\xfc\xf7\x7a\xd7\xfc\x74\xa8\x79\xc8\x5a\x6d\xb5\x39\x56\x63\x70\x76\x26\x67\xc2\x61\x72\xba\xe7\x64\x76\x7b\xb3\xbd\xdd\xfa\xec\x9a\xcd\xd2\x48\xa6\x5b\xdc\xb5\x31\x3d\x98\x53\xed\x4b\xcd\xc0\x22\xd5\xbe\xfe\x64\x6f\xe7\xc9\xde\xf6\x36\x95\x3f\x26\x6b\xba\x9d\x3e\x3b\xbe\x04\xfc\x0e\xc6\x58\xcf\xf0\x6c\xbc\x99\x63\xa2\x2e\x86\x78\x8e\x89\xba\xba\xde\x76\xe8\x23\x19\x15\x3a\xd6\x7a\x8c\xfc\x22\xd6\x57\x62\x7c\x17\xf2\xc8\xaf\x8a\xd8\xf3\xde\xb5\x11\xe7\x76\x11\xb9\x59\x13\x8c\xef\x02\xeb\x2b\x21\x88\x5c\xc4\x97\x47\x50\x8e\x62\x73\xf1\x1d\x32\x24\x00\x70\x6c\x84\x63\x78\x5e\x31\x0f\x2e\xea\x2a\x34\xe3\x7d\x66\x10\xa6\x01\xcd\xc8\x62\x6f\x9d\x86\x5a\x85\xc4\x90\x58\x0e\x45\xf0\x0c\xc6\xa0\x24\x61\x06\x4a\x55\x59\x0e\x95\x5b\x92\x06\x48\xb7\x94\xb3\xad\xc1\x56\x81\x9c\xa1\xdc\x03\xa7\x59\x83\xe9\xf6\x57\xcf\xdb\xd7\x1e\x2d\xc3\x46\xfb\xce\xfd\xfd\xad\x8f\x5b\x9f\x7c\x7b\xaa\x65\xf9\xe8\xf9\x61\x11\x23\x09\xae\x59\xb0\x5f\xa7\x9d\x08\x38\x63\x52\xcc\x14\x53\x48\x2f\x2c\x66\xc9\x85\x45\x07\x6f\x98\x29\x06\x18\x95\x80\x04\x03\x7f\xdc\xdd\x68\x5f\xdf\xea\xdc\xff\xa6\xb5\x7d\xe3\xe0\x37\xdf\x74\x6e\x7d\xd8\xd9\xfd\xbd\x79\xfa\x62\xb2\xa9\xc8\x4a\xcb\x85\xa2\xc2\x4a\x5c\xa5\x80\x02\x8d\x36\x66\x21\xb7\xf9\xee\x46\xeb\x93\x8f\x51\x34\xe4\xf6\x76\xfb\xcf\x1f\x9e\xd2\x11\xcb\xf9\x03\xc1\xd0\xe8\xd8\xb8\xb1\xef\x5c\x2a\x0e\xae\x0e\x35\x7d\xd8\x17\xf3\x2d\xf1\x87\x07\x1d\x28\x66\x8a\x31\x06\x4c\xf5\xbb\x55\xce\x71\xa1\x6d\xe6\xf5\xad\xfd\xbb\x1b\x7b\x3b\xff\x41\x02\xa5\x6c\x91\xa5\x68\x4a\x91\xeb\x9a\x20\x95\x6d\x6a\x6e\x9e\xe5\x9f\xa1\x28\x43\xae\xce\x45\x2c\x9d\xb8\x18\x4f\x17\x92\x89\x68\x7c\x3e\x13\x1f\x14\x99\x3a\xf8\xeb\x8b\xce\x47\x5f\xff\xb8\xbb\xb1\xff\xf5\x87\xad\x4f\xfe\xd8\xde\xb8\xda\x8d\x24\x1d\xfc\x6d\x63\x7f\xcb\x2e\x50\x5e\x11\x56\x04\xa9\x5c\x10\x05\x0e\x4a\x2a\x74\x89\x43\x11\x7a\xff\x6d\x82\x50\xbd\x50\x89\x22\x60\x8f\x15\x50\xc6\xd8\x51\xd2\x60\x07\x4a\x1e\xfc\xf5\xc5\xc1\x5f\x9f\x20\xbf\x82\x64\x90\xcf\x91\xff\x2f\x8a\x64\x05\x9c\x21\x4a\xeb\xa5\x0e\xbb\x9b\xb9\x75\xbd\x73\xf5\x79\xeb\xd9\xfa\xc1\xe7\xdf\xb5\x36\x3e\x72\x59\xf7\x8a\x82\xc6\xc9\x82\xdd\xcc\x0d\xc6\x3a\x99\xc8\x73\x81\x50\x3e\xc7\xfa\x96\xab\xbe\xf7\x19\xdf\xcc\xbb\xbe\xf9\x94\xef\xe7\x48\x48\x6b\xc1\x51\x3a\x14\x3a\x3c\xea\x61\x0c\xc9\x16\xec\xb5\x5f\x1b\x3c\x43\x7e\xf6\x85\x06\x71\xa0\xd9\x25\x2c\xf8\xe5\x97\xce\x6d\x15\x2f\x57\x59\x41\x2a\xf4\xed\xae\x2c\xa0\x27\x71\xd1\x48\x4c\xde\x93\x63\x11\xcb\x7e\x6e\x9c\xae\x5a\x72\xbd\xe4\x92\x0f\x45\xe1\xc7\x47\x9b\x56\x58\xef\xd2\xf0\x09\x90\xc7\x42\x87\x22\xaf\x05\xe9\x40\xb0\xb9\x34\x6c\x14\x19\xf9\x1e\x49\x54\x10\x1c\x41\x4e\xbf\x7e\x92\x2e\x07\x46\x83\x67\xd4\xac\xf7\x70\x1b\x6f\x3d\x2e\x18\x22\x2a\x04\x86\x0c\x81\xd9\xce\x61\xc9\x35\x7d\x1a\xbd\x10\xc3\xaa\x80\xed\x5e\x8b\xd0\x60\xf5\x50\x4d\x2b\xa3\x87\xab\xaa\x1d\xfc\xe9\xe3\xd6\x9f\xfe\xe2\xa6\x6a\xce\x10\x68\x22\xd5\xaf\x66\x89\x14\x99\x72\xe8\x30\x61\xe3\xa3\xd6\x67\xdf\xae\x84\x5b\x9f\x6f\xac\x8c\xda\x14\x2f\x91\x72\xdd\xaf\x9a\xb8\xa7\xd8\x95\x7a\x3c\x93\x91\xe0\x08\x3e\x9f\xd7\x83\xd8\x1e\xe3\xe3\x7d\x3d\xe7\x0f\xe0\x63\xfb\x09\x92\x9f\x44\x02\xfb\x2f\x84\xec\xdb\x00\x4c\x46\x3c\x4b\xaa\xbe\xc4\xa0\x95\xc0\x63\xdd\x4a\x07\xe8\x70\x33\xe2\x5d\x1b\xa3\xc7\x9a\xce\x62\xbd\x04\xc7\xfd\x11\x4f\x44\xf7\x44\x9c\x55\xc8\xc9\x0b\x37\xbd\xff\x9b\x94\x13\x8d\x0b\xd0\x4d\x3d\x12\xf1\x94\x4a\xa5\x92\x27\xe2\xef\x82\x05\x9a\x11\xf2\xf0\x78\xcc\xae\x7b\x48\xdf\xf5\x00\x2e\x27\xf7\x23\x2c\xc9\xa5\x61\xef\x5a\x08\xf9\xa5\xc7\x84\xd7\xdd\x06\x84\x1f\xaf\xaf\xc9\xbe\x16\x3d\x6e\x4c\x42\x3e\xf3\xa0\xee\x39\x7d\x1a\x13\x63\xa4\x39\x00\x21\x34\x00\x21\xdc\x1c\xc8\x00\x77\x84\xd0\x20\x84\x91\x01\x08\xc1\x41\x08\xa3\xcd\x3e\xf8\x01\x90\x63\xcd\x88\x57\x1f\xc4\xa4\xb1\xa6\x1e\xf1\x7a\x4d\x1d\x7d\xbf\xab\xbd\x3d\x83\x94\x48\x9d\x89\x31\x3a\xc5\xb2\xe7\x0c\x5a\x2f\x66\x0e\x89\x58\x77\x5e\x7c\xea\x1e\xaa\xae\xab\x05\xd7\x68\x75\x1f\xc2\x09\x37\x0f\x78\x2d\x98\x38\xe2\x84\x6b\x31\xc3\x58\x62\xc9\xff\x4d\xc2\xca\x81\xf1\x7e\xc1\xa0\x33\x8b\x82\xe5\x46\xb3\x8b\x6c\x88\xc3\xb5\xff\x9f\x0f\x9c\x07\x66\x75\xb5\x80\x3d\x9a\x43\x0e\xcb\x06\x50\x38\x8d\xc8\xc6\x8f\x3e\x97\xac\xa3\x53\x28\xec\x65\x21\xbe\x57\x20\x87\x9e\x46\xef\x7a\xa9\x21\x92\x44\x25\x2a\xbb\x82\x9c\x30\x72\x76\xf9\x9a\x8f\x72\x02\x13\xfd\xdc\x4f\x64\xdd\xb6\x83\x84\x69\x9d\xed\xef\x3b\xdf\x7c\xba\xb7\xbd\x4d\xe2\x08\x7d\xf7\x1a\xd9\xcb\x35\xb6\x01\x95\x82\xf1\xee\x9b\xc0\xe1\x0b\x28\x83\x85\x30\x80\xde\x49\xbd\xad\x09\xec\x2e\x79\x3d\x9e\x31\x22\x96\x40\x53\x47\xd7\xf5\xc6\x51\xca\xb8\xb1\x17\xec\xa6\xc3\x04\xc2\xeb\xf5\x38\xef\xe3\x39\xc8\xe5\x7c\x00\x01\x9e\x90\xaa\x89\x65\x27\x3e\x40\x39\x04\x89\x17\x56\x04\xbe\xce\xa2\x99\x6b\x72\x8f\xa2\x29\x41\x13\x24\x52\x82\xa7\x39\x29\xd4\xd8\xcb\x78\x1a\xa3\xba\xd7\xac\x17\x41\xff\xf1\xae\xb7\x1a\x52\x74\xde\x58\xb5\x4c\xc6\xc3\xaf\xba\xba\xa3\x9f\x54\xfc\xe4\xd8\x7a\x49\x7d\x03\x27\x72\xbe\xa5\xe1\x25\x35\x3f\x49\xae\x5b\xea\xd6\x32\x47\x3a\xdc\x1f\x60\x19\x04\x3d\x39\x69\x83\x1f\x74\xe4\xf7\x3f\xb7\xff\xfe\x7f\xbd\xfd\x67\x5b\xea\xba\xca\x7e\x86\x33\xca\x19\xbb\xcd\x4c\x17\xe6\xd3\x89\x68\xe1\x82\x6b\x2c\xe3\xf7\x8f\x5b\xbf\xfd\xaa\x75\xe7\xae\xfd\x2a\x94\x39\xaf\xd4\xb2\xeb\xed\xa9\x01\x58\x27\x9d\x4e\x93\x82\xd7\x93\xcb\x64\x2f\x4c\x13\x2f\x64\x6d\xac\x89\xae\xb6\xe5\x8f\xb2\x65\x25\x6c\xb2\x4a\x82\x84\xd6\x32\x49\x11\x38\xe3\x31\x64\xe3\xad\xe5\x6e\xd9\x19\x32\xd7\x19\x35\x3c\x74\x07\x61\x5d\xf9\xe9\xe5\x95\xce\x77\x3b\xad\x3f\xff\xae\x3f\xd6\x7b\xc8\x0e\xe2\x44\x7b\x87\xee\xd9\x11\xa2\x6a\xdc\x4e\xc2\x0d\x58\xd3\xf6\xc6\x28\x9a\x32\x22\xca\xd7\xee\x76\xd3\xa4\x39\x8a\xb6\xdc\xf7\x30\x76\x09\xc0\x95\x92\xfc\xda\x17\x89\xbe\xdb\x83\x4c\x7a\x20\xd3\xf7\x7f\x78\xd0\xb9\xba\x31\x90\xe3\x46\x20\xb2\x50\x13\x59\xcd\x75\xa9\xe8\xe2\x1f\x9f\xdf\x36\x9a\xe4\x66\x58\x8f\xbf\x84\x1e\x45\x9b\x50\x04\xc8\x16\xcf\x64\xce\xfa\xfc\x26\xe8\x8c\xbd\x1d\x75\x67\x66\x20\xbb\x0e\xb9\x32\x73\x22\x16\x59\x9c\x89\xae\x13\xd0\x7e\xb6\xd5\x7e\xf1\x3d\xba\xc2\x79\x77\x03\xc7\x80\xa9\xd6\xe6\xe3\xf6\xf3\x17\xbd\x92\xfc\xff\x43\x97\x6f\x5e\xab\x73\x12\x74\x46\xb0\xcc\x77\xf5\xec\xaa\xfd\xe8\x45\xeb\xde\x95\x44\xcc\x5d\x54\xbc\x60\x37\xd2\x26\xf4\x91\x41\xab\xae\x90\x78\xbc\xeb\xe3\x21\xfa\x78\x8e\x25\x59\x20\xe9\xc1\x95\x56\x65\x8e\x9d\x79\x60\x3e\x38\x76\xac\xd3\xf9\xd6\xd7\x5f\xb4\x3e\xbb\xe6\xce\x9a\xfe\x40\x32\x06\x3e\x32\x90\x6c\x70\x06\xe4\x28\x44\x01\x29\x28\xc6\x43\x89\xcf\xae\xb5\x1e\xde\xc4\x4e\x94\x08\x0b\x46\x2d\x19\x02\x02\x78\xcd\xe7\xf2\xc1\xf1\xbe\xb3\x8a\x74\x76\x26\xc6\xbc\xe7\x76\x3d\xfe\xcb\xf6\xcd\xfb\x03\x56\x1f\x41\xd1\x2a\x3c\xdb\x70\x5c\x8b\x47\x08\x27\x60\x8d\x49\x05\x33\x00\xe1\x52\x34\x29\x43\x53\xfc\x0f\x5f\xb6\xb6\xbf\xb6\xaf\x0f\xdd\xb7\x43\xcf\x90\x1f\x4e\xef\xd2\x7c\x2b\xd4\xae\x21\xcf\xff\xf3\xe0\xc5\x87\xee\xac\x60\xcb\x0e\x05\xc1\xb0\xc7\xe5\x42\x8e\x62\xcb\x58\x3d\x30\x16\x4a\xfc\x63\xbd\x7d\xe3\xf1\x51\xf6\x0b\x05\x0e\xbd\x3a\x39\x62\x9b\xec\x1d\xae\x1a\x47\x9e\xd3\x67\xad\x35\x21\xa7\xaf\x15\x8f\x2d\x46\xf1\xf7\x3b\x5c\x78\xf5\xf0\x41\xeb\xd3\x8f\xdd\x79\x05\xf9\xba\xe1\x6e\xc3\xcb\x35\xa8\x08\x50\xe2\x1c\xcc\xc3\xc8\xc7\xb7\x3b\x5d\x82\x88\x73\x18\x97\xa2\x7b\x85\xac\x88\x3f\x28\x54\xc6\x6f\x5a\x11\x88\x83\x3f\xfe\x05\x6d\x4f\x9e\x7d\xb1\xf7\xec\x96\x4d\xb9\xcc\x37\x77\xcf\x90\x69\x81\x3e\xeb\x83\x38\xc6\x24\x13\x59\x97\xd9\x86\x9c\xcc\xef\x06\x1a\x21\x32\x1a\x41\xb3\x4f\x38\x82\x73\x7c\x55\xb3\xd0\x41\xdc\xc0\xd8\x94\xcb\x91\x9e\xf9\x25\x95\x33\xe4\x85\xf3\xfa\x43\x26\xe3\x16\xc0\xb9\xf7\x62\x6f\xf7\xd6\xde\xcb\x3b\x07\x7f\xfc\x6b\xeb\xda\x5d\x77\x66\xa8\x32\x27\xb0\x62\x41\x90\xd4\x3a\xba\x11\x00\xfb\x7d\x0c\x07\x99\xe3\x6f\x35\x10\xe2\xcb\x3b\x64\x07\x9b\xc9\xcc\x23\x1b\x2d\xd7\x51\x6b\x40\x85\x5c\x5d\x11\xb4\x06\xe8\x6e\xce\x6c\x8d\x6c\x3e\x7d\xfd\x0e\x44\xc8\xe9\x40\x24\x17\x06\x4d\xc1\xce\xce\x66\x67\xfb\xef\xad\xed\x07\x7b\x2f\xef\xb6\xd7\xb7\x06\x6c\x67\x59\x4d\xd0\xea\x3c\x2c\xb0\x12\x5f\x10\x65\xa9\x4c\x72\x96\x97\xb2\xec\x4c\xb5\xd3\x3c\xbe\xd2\x99\xed\xa0\x7d\xac\xd9\x0a\x4a\xb3\x68\x47\x27\xa2\xd3\x7c\xaa\xb3\xb3\xd9\xda\x7e\x80\xe7\xe5\xed\xce\xce\x26\xda\xfa\xde\x7f\x49\x12\xa4\x4d\xa4\xad\xd7\x6e\x76\xb6\xff\x8e\x12\x1b\x38\xf1\xd3\xa8\xad\xd3\x7d\xe8\xbd\x9c\x6f\x63\x78\xaf\xd8\xe4\x6e\xaf\x24\xda\x57\x72\xa8\xb3\x6b\xb2\x2d\x1c\x0a\x8f\x71\x7c\xd8\x3f\xce\x05\x8a\x7c\x28\x34\xca\x8e\xfb\x03\xe3\xa3\x63\x7c\xc8\xcf\xb2\x1c\x1c\xf5\x53\x34\x15\x2e\x8d\x8d\x85\xc6\xf9\x52\x18\x86\x46\x02\x13\xfc\xf8\xa8\x1f\x86\x47\x46\xc2\x6c\x98\x63\x83\xa3\xfc\x88\x9f\x42\x2f\xfd\x55\xf9\x11\x0f\x85\xdb\xa6\xbc\x16\x96\x59\x7a\x7c\x76\xdc\x1a\x39\x66\x44\x0e\x87\x33\x7e\xdc\xdd\x70\xd5\xcb\x57\x79\xf1\x7c\xd0\xbc\xc6\x58\xd8\x81\xb8\xb1\xbf\x75\xb5\x9b\xed\x46\xb4\x70\x7b\x54\xfe\x98\x6f\xc3\x0d\x8a\xc0\xbc\xfe\x17\x1b\x43\xce\xcd\xfe\xa2\xdb\x66\x9f\xa0\x23\xea\x40\xe0\x6d\xbc\x45\x65\x8e\xbd\x7e\x0f\xb8\x60\xd4\x9c\xea\x52\xa5\xc9\x6b\x50\x17\x78\xda\xa0\x66\xe5\xd6\xe2\x99\x6f\xee\x43\xce\xcd\x7d\x2a\x9d\xb8\xc8\x64\xe3\x85\xd9\xb8\xcb\xf2\x9a\x8a\xcf\xb5\xbf\xda\x6d\xed\x6e\x76\xbe\x5e\x3f\xf8\xfc\x3e\x3a\xca\xbf\x47\x7c\xc5\xbe\xcb\x86\x35\x58\x2d\x18\xdf\x71\x28\x2c\x43\xc7\x16\x17\x63\xbb\x68\xde\x5c\x5d\xd4\x84\xa4\x20\x19\x1f\x09\x01\x43\xe4\x1b\x13\x4a\x5d\x52\x81\x2c\xe1\xb7\xbc\x57\x2b\xb2\x08\xc9\x77\x00\x69\xa0\xca\x40\xab\xb0\xe6\xeb\xe4\x1c\x2b\x81\x2a\xf9\x2e\x1f\xa7\xc8\xaa\x0a\x44\x41\x82\xea\x71\x5e\x21\x98\x54\xbd\x3e\xf4\x6f\x2a\x3e\x9d\x98\x07\x9e\x9c\x71\x69\xe3\x3c\xf0\xbe\x61\xf0\x03\xcc\xc6\xdf\xc3\x20\xc3\xe7\x27\xf1\x33\x3e\x1f\x3b\x1c\xf0\xe4\x96\x55\x85\x9c\x02\x35\x57\xf9\xb5\xb6\x3e\x3e\xf8\xfc\xbe\x9b\xfc\x9c\x9e\x48\x6a\x3a\x55\x98\x4a\x2e\x44\x67\x5d\xa4\x37\x9d\x22\x9c\x6f\x7d\xbe\x81\xc2\x87\x5b\x1f\xb7\x9f\x7c\xd2\x5e\xdf\x3a\x54\x8c\xe5\x5a\xa1\x28\xca\xdc\xb2\x4d\x80\xa9\xe9\x14\xe9\x4b\xeb\xce\xcd\xa3\xc5\x78\x72\x09\xa4\xa6\x53\xc0\x63\x61\x28\xc0\x23\xd2\xe7\xe2\x99\x0c\x33\x1d\xf7\xf6\x09\xe2\x38\xf0\x3f\x91\x3c\x9c\x9e\x50\x34\x9e\xce\x26\x2e\x24\xa2\x4c\x36\x7e\xc8\x7c\x42\xe7\xb1\xcf\x1f\x1c\x35\x9f\xd0\x87\x57\xc8\x59\x9b\x7d\xbf\x4f\xb0\x6d\x82\x08\x9e\x8d\x20\x3c\x9e\x9f\x8d\xf8\x27\xf4\x6c\x7a\x31\x93\x8d\xc7\xbc\xc0\x3b\x69\x19\x4f\xff\x7c\x38\x12\xfa\x75\x0a\x61\xc8\x80\x55\x41\x8d\xe5\x96\x5d\xbe\xe4\x12\x76\xfa\x5f\xef\x5e\x72\x09\xc8\xe1\xcf\x87\x5e\x82\x45\x90\x95\x97\xa1\xf4\xe3\xee\x46\x05\xb2\x3c\x54\xc8\xfb\xce\xed\x3f\x6c\x91\xcb\x4b\xac\x58\xee\xdc\xfa\x10\xc1\xda\xa4\xf4\x4b\x55\x96\x0a\xab\xb0\x58\xd0\x10\xb2\x4d\x48\xef\x5e\xca\xee\xed\xdc\xeb\x5c\xdd\x38\x45\xa8\x1e\x36\xde\x35\x5e\x3a\xf7\xfb\x26\x0a\xe8\xc6\xd9\x38\xdd\x7c\x7b\xcd\x4f\xa3\x2b\x65\x87\x56\xda\x6b\xde\x38\xe4\x62\xd9\xbb\x97\xb2\xd6\xa5\xc6\xf2\xc9\xa3\xb3\x9c\x1f\xe1\xbe\x9b\xed\x97\x32\x05\x26\x1a\x8d\x67\x32\xee\x4b\x0e\x73\x29\x03\x18\x0e\x7d\x58\x14\xcc\xc2\x06\x30\x17\x40\x33\x6c\xb0\xaa\xa2\x38\x38\xba\xd5\xbe\x0c\x1b\x05\xc7\xaa\xcc\x5c\xca\xec\x3f\x7a\x79\x70\xf3\x11\xe9\x4e\x22\x76\x0a\xd6\x7b\x98\xd9\x04\xa3\x33\x19\xf4\x33\x9d\x62\x74\x26\x11\x63\x74\x26\xbd\x80\x52\x28\x3b\x8f\x7f\x2e\x32\x3a\x13\xca\x9a\xcb\x81\xd7\x4c\xac\x05\x46\x0f\xbf\x10\x6a\x1f\xfd\x4f\xc1\xff\xa0\x0b\xff\x49\x5b\x83\xf9\x9f\xc1\x0d\x59\xc4\xd0\x7d\xff\x7f\xef\xd9\x6f\xf7\x9e\xfd\xae\xfd\xfb\x2b\x7d\x52\x31\x3a\xd7\x13\xce\x61\x92\x39\xcd\xb5\x8c\xae\x62\xbf\x79\x3e\xbf\x16\xf6\x1f\x71\x39\xc3\xb5\x4b\x14\x6d\x2d\x27\x05\x6e\x40\xa4\x8c\x14\x39\xd1\x48\xa6\xef\xd6\xb4\x9d\xad\xb6\xab\xf4\xc9\xe4\xd9\x4b\xd5\xe9\xd5\x4e\x27\xb2\x33\x8b\x53\x85\xec\xc2\x6c\xdc\xc5\xb3\x9d\x16\xb4\x99\x7a\xcf\xc0\x19\x5f\x23\xb9\x7a\xad\xb3\xfb\x41\xeb\xf3\x0d\xfc\x09\x52\x52\xb6\xf7\x83\xfd\x5e\x70\x59\xd0\x2a\x75\x37\xe3\x46\x28\x9e\xda\xbe\x79\xca\x95\x9a\x5e\xae\xc8\x7a\xb9\x52\xd7\xcb\x15\x55\x2f\x57\x14\x6f\xa1\x27\xe2\xfc\x5a\x68\xd4\xf9\xf6\x90\xd1\x97\x1a\xab\x59\x00\x0b\xf9\xb5\xf1\xe0\xe1\x53\xce\xca\x9a\x9f\x62\xc2\x85\xfb\x45\x93\x64\x0e\x13\x4d\x92\x1d\x28\x9a\x83\x1b\x2f\x5b\xdb\x0f\x9c\x22\x11\xd9\x01\x22\x49\xb2\xaf\x22\x12\xb1\xc6\x6a\x7a\x59\x94\x59\x55\x2f\x8b\x3c\x4a\x2a\xe8\xa7\xa6\xa1\xdf\x12\xfa\x51\x65\x56\xf3\xfa\x1c\x8b\x4f\xd0\x4f\x37\x6d\x94\xa6\xd3\x81\x50\x78\x7c\x22\x1c\x18\x04\x38\x50\x4e\x49\xe6\x27\x94\x93\xd3\xed\xce\x24\x99\xe8\xec\x20\x31\x65\xd0\x97\xac\x06\x49\xa9\x7d\xe3\x71\xeb\xe1\x4d\xc7\x4a\xa5\x22\x0c\x17\x31\x61\x4a\xa7\x96\xd2\x65\xf9\x72\x8e\x2d\xc2\x9a\xa2\xe6\x7d\xf8\x1e\x67\xfe\xbc\xcf\x7b\xde\x32\x71\xce\x1f\x3a\x17\x2c\x63\xfc\x29\x58\x3c\xea\x72\x2a\xda\xfd\x1c\xa2\x3d\x8e\xbb\xf5\x71\xe7\xab\x75\xe4\xa7\x60\x62\x83\xcf\x46\xd1\x07\xc1\xec\xd1\x5c\x8c\x79\xb2\x83\x51\x44\xc4\xb8\x65\xb9\x8a\x13\xab\x66\xb6\x6b\xfc\xd1\xa9\x32\xfe\xa3\x15\x3d\xb3\x6f\x5b\x36\xd8\x9a\xd0\x4b\x19\x09\xb2\x84\x60\x91\xa3\x6c\x5d\xab\x74\x33\x16\xcf\x18\x05\xe3\x70\x9f\x51\x62\xf3\x3f\xf6\x76\xee\x1d\x3b\x84\xd2\xfb\x68\x1b\x25\xd5\xf1\x25\x22\x49\xc0\xbf\xc6\xf5\x23\xa5\x8e\x1e\xf8\xaf\x6a\x50\x34\xb5\x44\x2d\x51\xd4\x6b\x5f\x89\x9c\xf1\x84\xd8\x54\x61\x31\xed\xf2\xd9\x28\xb2\x8d\x26\x23\xef\xdc\xfa\xd0\x70\x27\xb7\xbf\xd8\x7f\xf9\xe7\xf6\xa7\xf7\xf7\x9e\xfd\x83\x7c\x74\xa8\xb5\xf5\x9c\xc0\x1c\xfc\xe6\x1b\xf4\xd6\xdd\xd5\x2f\x9c\x57\xcf\x50\xf7\x8a\xac\x0a\x0b\x75\x45\xb4\x9f\x92\xf7\x91\x3c\x8d\x19\xfc\x25\x5f\xe4\x22\xde\x49\x4f\x4d\x56\xb5\xb2\x02\x55\xdd\x4c\xfc\x4a\xd4\xab\x0d\xfc\xcb\x2a\x02\xcb\x17\xf5\xaa\x2c\x95\xe5\xde\x73\xe9\xbc\xaa\xac\xe8\xe8\x53\x26\x2a\xf9\x55\x75\xb6\xfa\xab\x1a\xfe\x51\xf5\xaa\x8a\x50\xd5\x5f\x89\xe8\x6f\xa5\x40\x45\x97\x15\x96\x13\xa1\xce\x89\x02\xb7\x5c\x91\xeb\x2a\xf4\x46\xde\x7c\x33\xf7\x8b\x25\x35\xf2\xe6\x3b\xf9\xf3\x11\x94\x7a\xe7\xcd\xfc\xf9\x77\x50\xe2\xcd\xc9\xa1\xfc\xf9\xc3\x5e\x11\xc1\x2c\xb7\xc5\x8d\x1c\x9f\x1e\x3d\x53\x89\x3b\x23\x10\x33\x89\xe9\x99\x42\x7c\x3e\x9b\x5e\x48\xbd\x57\xb0\x98\x12\xfb\xd5\x9c\x6f\xff\xd0\xf9\xf8\x9f\x07\xb7\x36\xd1\x15\xae\x87\x37\x3b\x7f\x7f\x40\x44\xbe\x0c\x1b\x64\x67\xd9\xfa\xcd\x26\x9e\x2c\x6f\x92\x3e\xfd\xb8\xbb\xb1\xb8\x98\x88\x21\x8b\xf0\xc5\x27\xad\x67\x1b\xad\x0f\x76\xf7\x76\x6f\xed\xff\xe5\x5b\xf4\xf9\xa9\x9d\x7b\x36\x8d\xa8\x08\xe5\x4a\x01\xfd\x2d\x09\xb9\xd6\x30\xa6\xab\xfd\x52\x0f\x6e\xdb\x65\xe7\x19\x1a\xf4\xe1\x49\xab\x83\x8c\x7b\xa5\x13\xb2\x3a\xfa\x54\x0d\xba\x00\xcb\x8a\x3a\xb2\x00\x93\xcb\xb0\xa1\x1b\xf3\x9e\xa4\xeb\x5a\xc5\xfc\xd0\xc0\x10\x88\x93\x3e\x45\x00\xa6\x01\xe4\x12\x30\x3e\xac\x8b\xa2\x5a\x2a\x04\x6a\x85\x95\x24\x59\x02\x46\xdf\xc1\xbf\xbe\x0d\xb2\x15\x05\xaa\x15\x59\xe4\xf1\xe7\x55\xe7\x04\x29\x09\x25\xf0\xd6\xdb\x40\xc4\x9f\xce\x45\x29\xf4\x57\x60\xa0\x44\x77\x49\x09\x6a\xdf\x97\xe5\xf1\x9f\x56\x61\x92\xf3\x8b\x73\xf9\xae\xc4\x49\x4f\x2c\x03\x33\xf0\x23\x80\x60\x75\x6b\x40\xaf\x0f\x11\x10\x1e\xf6\x5b\x2a\x48\x77\xba\x97\x48\x8d\x42\xdc\x1f\x74\x07\x66\xf4\xf8\x66\xcc\xc1\xe4\x5f\x58\x5f\x3a\x18\x6f\xfa\xac\xd9\xf0\x49\xb2\x81\x60\xf3\x7f\x81\x21\xb0\x68\x06\x4e\x0d\xf2\xb6\x57\x1e\x42\xc1\xa6\xed\xed\x90\xb0\xdf\x9e\x1f\x0d\x37\xbd\x88\x08\xfe\x3e\x7f\x66\x86\x09\xe0\x5f\x73\x78\x67\x6d\x4a\x1d\x51\x0c\xf4\x15\x7e\xfb\x17\x6a\xa1\xc4\xff\xdf\x01\x00\xd8\x22\x84\x7d\x2f\x6a\x00\x00")
 
 func confYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -84,8 +84,8 @@ func confYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "conf.yml", size: 20327, mode: os.FileMode(0644), modTime: time.Unix(1664267981, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3d, 0x2a, 0xa4, 0x56, 0xac, 0x42, 0xdc, 0x98, 0x7f, 0xb5, 0xc6, 0xf9, 0xe, 0xc1, 0x76, 0xd7, 0x8, 0x55, 0xd7, 0xd7, 0xfe, 0xd1, 0xc0, 0x9e, 0x81, 0x46, 0x25, 0x19, 0x63, 0x5f, 0x73, 0x68}}
+	info := bindataFileInfo{name: "conf.yml", size: 27183, mode: os.FileMode(0644), modTime: time.Unix(1792363871, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x34, 0x91, 0xb0, 0x29, 0x83, 0x14, 0xa9, 0x33, 0xe2, 0x84, 0x9, 0x25, 0x9a, 0x3e, 0x3, 0x61, 0xe, 0x86, 0x51, 0xd4, 0x46, 0xd8, 0xd1, 0xf4, 0x25, 0x72, 0x71, 0x67, 0xae, 0xc6, 0x49, 0x7f}}
 	return a, nil
 }
 
@@ -22,19 +22,41 @@ type MaskRuleItem struct {
 	IgnoreKind    []string `yaml:"IgnoreKind"` // one of [NUMERIC, ALPHA_UPPER_CASE, ALPHA_LOWER_CASE, WHITESPACE, PUNCTUATION]
 }
 
+// EntropyItem flags tokens of CharSet whose shannon entropy is above Threshold and length is within [MinLen, MaxLen]
+type EntropyItem struct {
+	CharSet   string  `yaml:"CharSet"`   // one of [BASE64, HEX, ALNUM]
+	Threshold float64 `yaml:"Threshold"` // bits per char, BASE64 <= 6, HEX <= 4, ALNUM <= 5.95
+	MinLen    int32   `yaml:"MinLen"`
+	MaxLen    int32   `yaml:"MaxLen"` // 0 means no limit
+}
+
 type RuleItem struct {
 	RuleID      int32  `yaml:"RuleID"`
 	InfoType    string `yaml:"InfoType"`
 	Description string `yaml:"Description"`
 	EnName      string `yaml:"EnName"`
 	CnName      string `yaml:"CnName"`
-	Level       string `yaml:"Level"` // L1 (least Sensitive) ~ L4 (Most Sensitive)
+	Level       string `yaml:"Level"`     // L1 (least Sensitive) ~ L4 (Most Sensitive)
+	MultiLine   bool   `yaml:"MultiLine"` // true: VALUE rule runs on the whole input instead of each line, such as PEM keys
+	Score       int32  `yaml:"Score"`     // priority of rule when Global.OverlapPolicy is SCORE, greater wins
+	// VDict and BDict ignore case, such as Test for test
+	DictCaseInsensitive bool `yaml:"DictCaseInsensitive"`
+	// word of VDict and BDict must be a whole word, such as test is not found in testing
+	DictWholeWord bool `yaml:"DictWholeWord"`
+	// whole-word decisions of CDict, VDict and BDict split CJK text by bundled lexicon, such as 电话 is not a word in 充电话题
+	Segment bool `yaml:"Segment"`
 	// (KReg || KDict) && (VReg || VDict)
 	Detect struct {
 		KReg  []string `yaml:"KReg"`       // Regex List for Key
 		KDict []string `yaml:"KDict,flow"` // Dict for Key
+		// max edit distance for fuzzy match of KDict, such as phoen for phone, 0 means exact match
+		KDictFuzzy int32 `yaml:"KDictFuzzy"`
+		// path patterns for Key of JSON, such as /user/*/contacts[*]/phone or **/billing/card
+		KPath []string `yaml:"KPath"`
 		VReg  []string `yaml:"VReg"`       // Regex List for Value
 		VDict []string `yaml:"VDict,flow"` // Dict for Value
+		// Entropy list for Value, such as secrets without fixed format
+		Entropy []EntropyItem `yaml:"Entropy"`
 	} `yaml:"Detect"`
 	// result which is hit by blacklist will not returned to caller
 	Filter struct {
@@ -56,14 +78,26 @@ type RuleItem struct {
 
 type DlpConf struct {
 	Global struct {
-		Date           string  `yaml:"Date"`
-		ApiVersion     string  `yaml:"ApiVersion"`
-		Mode           string  `yaml:"Mode"`
-		AllowRPC       bool    `yaml:"AllowRPC"`
-		EnableRules    []int32 `yaml:"EnableRules,flow"`
-		DisableRules   []int32 `yaml:"DisableRules,flow"`
-		MaxLogInput    int32   `yaml:"MaxLogInput"`
-		MaxRegexRuleID int32   `yaml:"MaxRegexRuleID"`
+		Date           string   `yaml:"Date"`
+		ApiVersion     string   `yaml:"ApiVersion"`
+		Mode           string   `yaml:"Mode"`
+		AllowRPC       bool     `yaml:"AllowRPC"`
+		EnableRules    []int32  `yaml:"EnableRules,flow"`
+		DisableRules   []int32  `yaml:"DisableRules,flow"`
+		MaxLogInput    int32    `yaml:"MaxLogInput"`
+		MaxRegexRuleID int32    `yaml:"MaxRegexRuleID"`
+		MaxDecodeDepth int32    `yaml:"MaxDecodeDepth"`  // max depth of nested decoding in Detect(), 0 means decoding is disabled
+		Decoders       []string `yaml:"Decoders,flow"`   // built-in decoders, one of [BASE64, URL, HEX, HTML], empty means all
+		OverlapPolicy  string   `yaml:"OverlapPolicy"`   // how to resolve overlapped results, one of defOverlapPolicy, empty means DEFAULT
+		Extractors     []string `yaml:"Extractors,flow"` // built-in KV extractors, one of [QUERY, COOKIE, HEADER, LOGFMT, STRUCT]
+		JSONNumberMask string   `yaml:"JSONNumberMask"`  // how masked JSON number is written back, STRING or KEEP_TYPE, empty means STRING
+		JSONMaskDigit  string   `yaml:"JSONMaskDigit"`   // digit which replaces masked digits in KEEP_TYPE mode, one of 0-9, empty means 9
+		// max entries of LRU cache for Detect, Deidentify, DetectJSON and DeidentifyJSON, 0 means disabled
+		ResultCacheSize int32 `yaml:"ResultCacheSize"`
+		// workers of DetectBatch, DeidentifyBatch and DeidentifyJSONBatch, 0 means GOMAXPROCS
+		BatchWorkers int32 `yaml:"BatchWorkers"`
+		// preprocessing before detection in Detect(), one of [INVISIBLE, CONFUSABLE], empty means none
+		Preprocess []string `yaml:"Preprocess,flow"`
 	} `yaml:"Global"`
 	MaskRules []MaskRuleItem `yaml:"MaskRules"`
 	Rules     []RuleItem     `yaml:"Rules"`
@@ -92,8 +126,14 @@ var (
 	defModeSet          []string = []string{"debug", "release"}
 	defAPIVersionPrefix          = "v2"
 	defMaskTypeSet      []string = []string{"CHAR", "TAG", "REPLACE", "ALGO"}
-	defMaskAlgo         []string = []string{"BASE64", "MD5", "CRC32", "ADDRESS", "NUMBER", "DEIDENTIFY"}
+	defMaskAlgo         []string = []string{"BASE64", "MD5", "CRC32", "ADDRESS", "NUMBER", "DEIDENTIFY", "URLPASSWORD"}
 	defIgnoreKind       []string = []string{"NUMERIC", "ALPHA_UPPER_CASE", "ALPHA_LOWER_CASE", "WHITESPACE", "PUNCTUATION"}
+	defDecoderSet       []string = []string{"BASE64", "URL", "HEX", "HTML"}
+	defEntropyCharSet   []string = []string{"BASE64", "HEX", "ALNUM"}
+	defOverlapPolicy    []string = []string{"DEFAULT", "LEVEL", "SCORE", "LONGEST", "UNION", "KEEP_ALL"}
+	defExtractorSet     []string = []string{"QUERY", "COOKIE", "HEADER", "LOGFMT", "STRUCT"}
+	defJSONNumberMask   []string = []string{"STRING", "KEEP_TYPE"}
+	defPreprocessSet    []string = []string{"INVISIBLE", "CONFUSABLE"}
 )
 
 func (I *DlpConf) Verify() error {
@@ -108,6 +148,50 @@ func (I *DlpConf) Verify() error {
 	if inList(I.Global.Mode, defModeSet) == -1 { // not found
 		return fmt.Errorf("%w, Global.Mode:%s failed", errlist.ERR_CONF_VERIFY_FAILED, I.Global.Mode)
 	}
+	// Decoders
+	if I.Global.MaxDecodeDepth < 0 {
+		return fmt.Errorf("%w, Global.MaxDecodeDepth: %d need >=0", errlist.ERR_CONF_VERIFY_FAILED, I.Global.MaxDecodeDepth)
+	}
+	for _, name := range I.Global.Decoders {
+		if inList(name, defDecoderSet) == -1 {
+			return fmt.Errorf("%w, Global.Decoders: %s is not supported", errlist.ERR_CONF_VERIFY_FAILED, name)
+		}
+	}
+	// Extractors
+	for _, name := range I.Global.Extractors {
+		if inList(name, defExtractorSet) == -1 {
+			return fmt.Errorf("%w, Global.Extractors: %s is not supported", errlist.ERR_CONF_VERIFY_FAILED, name)
+		}
+	}
+	// JSONNumberMask
+	I.Global.JSONNumberMask = strings.ToUpper(I.Global.JSONNumberMask)
+	if len(I.Global.JSONNumberMask) != 0 && inList(I.Global.JSONNumberMask, defJSONNumberMask) == -1 {
+		return fmt.Errorf("%w, Global.JSONNumberMask: %s is not supported", errlist.ERR_CONF_VERIFY_FAILED, I.Global.JSONNumberMask)
+	}
+	// JSONMaskDigit
+	if d := I.Global.JSONMaskDigit; len(d) != 0 && (len(d) != 1 || d[0] < '0' || d[0] > '9') {
+		return fmt.Errorf("%w, Global.JSONMaskDigit: %s is not a digit", errlist.ERR_CONF_VERIFY_FAILED, d)
+	}
+	// OverlapPolicy
+	I.Global.OverlapPolicy = strings.ToUpper(I.Global.OverlapPolicy)
+	if len(I.Global.OverlapPolicy) != 0 && inList(I.Global.OverlapPolicy, defOverlapPolicy) == -1 {
+		return fmt.Errorf("%w, Global.OverlapPolicy: %s is not supported", errlist.ERR_CONF_VERIFY_FAILED, I.Global.OverlapPolicy)
+	}
+	// ResultCacheSize
+	if I.Global.ResultCacheSize < 0 {
+		return fmt.Errorf("%w, Global.ResultCacheSize: %d need >=0", errlist.ERR_CONF_VERIFY_FAILED, I.Global.ResultCacheSize)
+	}
+	// BatchWorkers
+	if I.Global.BatchWorkers < 0 {
+		return fmt.Errorf("%w, Global.BatchWorkers: %d need >=0", errlist.ERR_CONF_VERIFY_FAILED, I.Global.BatchWorkers)
+	}
+	// Preprocess
+	for i, name := range I.Global.Preprocess {
+		I.Global.Preprocess[i] = strings.ToUpper(name)
+		if inList(I.Global.Preprocess[i], defPreprocessSet) == -1 {
+			return fmt.Errorf("%w, Global.Preprocess: %s is not supported", errlist.ERR_CONF_VERIFY_FAILED, name)
+		}
+	}
 	// MaskRules
 	for _, rule := range I.MaskRules {
 		// MaskType
@@ -135,9 +219,20 @@ func (I *DlpConf) Verify() error {
 	for _, rule := range I.Rules {
 		de := rule.Detect
 		// at least one detect rule
-		if len(de.KReg) == 0 && len(de.KDict) == 0 && len(de.VReg) == 0 && len(de.VDict) == 0 {
+		if len(de.KReg) == 0 && len(de.KDict) == 0 && len(de.KPath) == 0 && len(de.VReg) == 0 && len(de.VDict) == 0 && len(de.Entropy) == 0 {
 			return fmt.Errorf("%w, RuleID:%d, Detect field missing", errlist.ERR_CONF_VERIFY_FAILED, rule.RuleID)
 		}
+		if de.KDictFuzzy < 0 || de.KDictFuzzy > 2 {
+			return fmt.Errorf("%w, RuleID:%d, KDictFuzzy: %d need in [0, 2]", errlist.ERR_CONF_VERIFY_FAILED, rule.RuleID, de.KDictFuzzy)
+		}
+		for _, item := range de.Entropy {
+			if inList(item.CharSet, defEntropyCharSet) == -1 {
+				return fmt.Errorf("%w, RuleID:%d, Entropy CharSet: %s is not supported", errlist.ERR_CONF_VERIFY_FAILED, rule.RuleID, item.CharSet)
+			}
+			if !(item.Threshold > 0) || item.MinLen < 0 || (item.MaxLen > 0 && item.MaxLen < item.MinLen) {
+				return fmt.Errorf("%w, RuleID:%d, Entropy Threshold: %v, MinLen: %d, MaxLen: %d is invalid", errlist.ERR_CONF_VERIFY_FAILED, rule.RuleID, item.Threshold, item.MinLen, item.MaxLen)
+			}
+		}
 	}
 	return nil
 }
@@ -0,0 +1,173 @@
+// Package decoder implements decoder functions, which find encoded segments and decode them for detection
+package decoder
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bytedance/godlp/errlist"
+)
+
+const (
+	DECODER_BASE64 = "BASE64"
+	DECODER_URL    = "URL"
+	DECODER_HEX    = "HEX"
+	DECODER_HTML   = "HTML"
+)
+
+// regex of encoded segments for built-in decoders
+const (
+	segRegBase64 = `[A-Za-z0-9+/_\-]{16,}={0,2}`
+	segRegURL    = `(?:%[0-9A-Fa-f]{2}|[A-Za-z0-9._~+\-])*%[0-9A-Fa-f]{2}(?:%[0-9A-Fa-f]{2}|[A-Za-z0-9._~+\-])*`
+	segRegHex    = `\b(?:[0-9A-Fa-f]{2}){6,}\b`
+	segRegHTML   = `(?:&(?:#[0-9]{1,7}|#[xX][0-9A-Fa-f]{1,6}|[A-Za-z]{2,8});|[A-Za-z0-9@._+\-])*&(?:#[0-9]{1,7}|#[xX][0-9A-Fa-f]{1,6}|[A-Za-z]{2,8});(?:&(?:#[0-9]{1,7}|#[xX][0-9A-Fa-f]{1,6}|[A-Za-z]{2,8});|[A-Za-z0-9@._+\-])*`
+)
+
+// DecodeFunc decodes an encoded segment
+type DecodeFunc func([]byte) ([]byte, error)
+
+type Decoder struct {
+	name       string
+	segReg     *regexp.Regexp // regex for finding encoded segments
+	decodeFunc DecodeFunc
+}
+
+type DecoderAPI interface {
+	// GetName returns name of decoder, which is recorded in DetectResult.EncodingPath
+	GetName() string
+	// FindSegments returns position list of encoded segments in input, each position is [start, end)
+	FindSegments(in []byte) [][]int
+	// Decode decodes an encoded segment, decoded bytes need to be printable text
+	Decode(segment []byte) ([]byte, error)
+}
+
+// BuiltinNames returns names of built-in decoders
+func BuiltinNames() []string {
+	return []string{DECODER_BASE64, DECODER_URL, DECODER_HEX, DECODER_HTML}
+}
+
+// NewDecoder creates decoder object by segment regex and decode function
+func NewDecoder(name string, segReg string, decodeFunc DecodeFunc) (DecoderAPI, error) {
+	if decodeFunc == nil {
+		return nil, errlist.ERR_DECODER_FUNC_EMPTY
+	}
+	re, err := regexp.Compile(segReg)
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Decoder)
+	obj.name = name
+	obj.segReg = re
+	obj.decodeFunc = decodeFunc
+	return obj, nil
+}
+
+// NewBuiltinDecoder creates built-in decoder object by name, one of [BASE64, URL, HEX, HTML]
+func NewBuiltinDecoder(name string) (DecoderAPI, error) {
+	switch name {
+	case DECODER_BASE64:
+		return NewDecoder(name, segRegBase64, decodeBase64)
+	case DECODER_URL:
+		return NewDecoder(name, segRegURL, decodeURL)
+	case DECODER_HEX:
+		return NewDecoder(name, segRegHex, decodeHex)
+	case DECODER_HTML:
+		return NewDecoder(name, segRegHTML, decodeHTML)
+	default:
+		return nil, errlist.ERR_DECODER_NOTFOUND
+	}
+}
+
+// public func
+
+// GetName returns name of decoder
+func (I *Decoder) GetName() string {
+	return I.name
+}
+
+// FindSegments returns position list of encoded segments in input
+func (I *Decoder) FindSegments(in []byte) [][]int {
+	return I.segReg.FindAllIndex(in, -1)
+}
+
+// Decode decodes an encoded segment, returns error if decoded bytes are not printable text
+func (I *Decoder) Decode(segment []byte) ([]byte, error) {
+	out, err := I.decodeFunc(segment)
+	if err != nil {
+		return nil, err
+	}
+	if !isPrintable(out) {
+		return nil, errlist.ERR_DECODE_NOT_PRINTABLE
+	}
+	return out, nil
+}
+
+// private func
+
+// decodeBase64 decodes std or url base64, with or without padding
+// segment without padding must mix upper case, lower case and digit or symbol, so that ordinary words are not decoded
+func decodeBase64(in []byte) ([]byte, error) {
+	str := string(in)
+	if !strings.HasSuffix(str, "=") && !isMixedBase64(str) {
+		return nil, errlist.ERR_DECODE_NOT_ENCODED
+	}
+	enc := base64.StdEncoding
+	if strings.ContainsAny(str, "-_") {
+		enc = base64.URLEncoding
+	}
+	if !strings.HasSuffix(str, "=") {
+		enc = enc.WithPadding(base64.NoPadding)
+	}
+	return enc.DecodeString(str)
+}
+
+// isMixedBase64 checks whether str has upper case letter, lower case letter, and digit or symbol of base64
+func isMixedBase64(str string) bool {
+	hasUpper, hasLower, hasOther := false, false, false
+	for i := 0; i < len(str); i++ {
+		switch c := str[i]; {
+		case 'A' <= c && c <= 'Z':
+			hasUpper = true
+		case 'a' <= c && c <= 'z':
+			hasLower = true
+		default:
+			hasOther = true
+		}
+	}
+	return hasUpper && hasLower && hasOther
+}
+
+// decodeURL decodes percent-encoded segment
+func decodeURL(in []byte) ([]byte, error) {
+	out, err := url.QueryUnescape(string(in))
+	return []byte(out), err
+}
+
+// decodeHex decodes hex string
+func decodeHex(in []byte) ([]byte, error) {
+	return hex.DecodeString(string(in))
+}
+
+// decodeHTML decodes html entities
+func decodeHTML(in []byte) ([]byte, error) {
+	return []byte(html.UnescapeString(string(in))), nil
+}
+
+// isPrintable checks whether in is utf8 text without control char
+func isPrintable(in []byte) bool {
+	if len(in) == 0 || !utf8.Valid(in) {
+		return false
+	}
+	for _, r := range string(in) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,119 @@
+package detector
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	PATH_ANY_KEY   = "*"   // matches one key or index
+	PATH_ANY_INDEX = "[*]" // matches one array index
+	PATH_ANY_DEPTH = "**"  // matches zero or more keys and indexes
+)
+
+// public func
+
+// EscapeJSONPointer escapes a reference token of RFC 6901 JSON Pointer, ~ is ~0 and / is ~1
+func EscapeJSONPointer(token string) string {
+	if strings.IndexAny(token, "~/") == -1 {
+		return token
+	}
+	token = strings.Replace(token, "~", "~0", -1)
+	return strings.Replace(token, "/", "~1", -1)
+}
+
+// private func
+
+// compileKPath splits path pattern into tokens, such as /user/*/contacts[*]/phone is [user * contacts [*] phone]
+// key tokens are normalized by normalizeKey, pattern without leading / matches at any depth
+func compileKPath(pattern string) []string {
+	tokens := make([]string, 0, DEF_RESULT_SIZE)
+	if !strings.HasPrefix(pattern, "/") && !strings.HasPrefix(pattern, PATH_ANY_DEPTH) {
+		tokens = append(tokens, PATH_ANY_DEPTH)
+	}
+	for _, seg := range strings.Split(strings.TrimPrefix(pattern, "/"), "/") {
+		if len(seg) == 0 {
+			continue
+		}
+		key := seg
+		indexes := ""
+		if strings.HasSuffix(seg, "]") {
+			if pos := strings.IndexByte(seg, '['); pos != -1 {
+				key = seg[:pos]
+				indexes = seg[pos:]
+			}
+		}
+		switch key {
+		case "":
+		case PATH_ANY_KEY, PATH_ANY_DEPTH:
+			tokens = append(tokens, key)
+		default:
+			tokens = append(tokens, normalizeKey(key))
+		}
+		// [*][0] => [*] [0]
+		for _, idx := range strings.Split(indexes, "]") {
+			if len(idx) > 1 && idx[0] == '[' {
+				tokens = append(tokens, idx+"]")
+			}
+		}
+	}
+	return tokens
+}
+
+// splitJSONPointer splits RFC 6901 JSON Pointer into unescaped reference tokens
+func splitJSONPointer(pointer string) []string {
+	if len(pointer) == 0 {
+		return nil
+	}
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, tok := range tokens {
+		if strings.IndexByte(tok, '~') != -1 {
+			tok = strings.Replace(tok, "~1", "/", -1)
+			tokens[i] = strings.Replace(tok, "~0", "~", -1)
+		}
+	}
+	return tokens
+}
+
+// matchKPath checks whether pointer tokens match any pattern in KPath
+func (I *Detector) matchKPath(tokens []string) bool {
+	for _, pattern := range I.KPath {
+		if matchPathTokens(pattern, tokens) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPathTokens matches pattern tokens with pointer tokens, ** matches zero or more tokens
+func matchPathTokens(pattern []string, tokens []string) bool {
+	if len(pattern) == 0 {
+		return len(tokens) == 0
+	}
+	if pattern[0] == PATH_ANY_DEPTH {
+		for i := 0; i <= len(tokens); i++ {
+			if matchPathTokens(pattern[1:], tokens[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(tokens) == 0 || !matchPathToken(pattern[0], tokens[0]) {
+		return false
+	}
+	return matchPathTokens(pattern[1:], tokens[1:])
+}
+
+// matchPathToken matches one pattern token with one pointer token, numeric pointer token is array index
+func matchPathToken(pattern string, token string) bool {
+	if pattern == PATH_ANY_KEY {
+		return true
+	}
+	if strings.HasPrefix(pattern, "[") {
+		if _, err := strconv.Atoi(token); err != nil {
+			return false
+		}
+		return pattern == PATH_ANY_INDEX || pattern[1:len(pattern)-1] == token
+	}
+	return pattern == normalizeKey(token)
+}
@@ -0,0 +1,31 @@
+package detector
+
+// defLexicon is the bundled lexicon of segmenter, words are separated by white space
+// it has common words, words of context and compounds which contain them, such as 充电 and 话题 for 充电话题
+// compounds of context words with the same meaning, such as 手机号码, are not in it, so that 手机号 is still a word there
+var defLexicon = `
+电话 手机 手机号 号码 联系 传真 座机 固话 分机 来电 去电 致电 通话 短信 微信 邮箱 邮件 邮编 邮政编码
+地址 住址 收件人 收货人 寄件人 发件人 姓名 名字 全名 用户名 昵称 性别 年龄 生日 出生 籍贯 民族 国籍
+身份证 证件 护照 签证 驾驶证 驾照 军官证 户口 户口本 社保 社保卡 医保 医保卡 公积金
+银行 银行卡 信用卡 借记卡 储蓄卡 卡号 账号 账户 开户 开户行 支行 分行 代码 密码 口令 验证码 密钥 令牌 车牌 车牌号 工号 学号 编号 订单号
+充电 充电器 停电 断电 发电 发电机 用电 电费 话费 电力 电脑 电视 电视机 电影 电子 电器 电池 电梯 电源 电线 电台 电视台 电动 电动车 闪电 雷电
+话题 话语 话剧 说话 对话 讲话 谈话 笑话 童话 神话 废话 回话 答话 话筒 会话
+随手 顺手 动手 对手 选手 高手 新手 助手 手术 手续 手段 手工 手套 手表 机会 机构 机器 机场 机关 机制 飞机 司机 时机 危机 相机 耳机 主机 转机
+号召 号称 口号 信号 型号 符号 记号 称号 问号 句号 挂号 码头 起码 数码 条码 二维码 页码 编码 解码 筹码 砝码
+关联 联合 联盟 联网 联络 联想 联赛 互联网 系统 系列 关系 体系 派系 直系 维系 系数 系统性
+宣传 传说 传统 传播 传递 传承 流传 遗传 真的 真正 真实 真相 认真 天真 真心 真理
+保护 照片 拍照 照顾 照明 按照 参照 关照 护士 护理 维护 爱护 防护 守护 辩护
+标签 签名 签字 签约 签到 签署 证明 证书 证据 保证 认证 验证 论证 证券 证实 见证
+地方 地区 地图 地点 地铁 地球 地面 土地 当地 各地 基地 场地 外地 本地
+姓氏 名单 名称 名人 名额 著名 报名 排名 命名 点名 名片 有名 出名
+信用 信息 信任 相信 自信 来信 书信 卡片 卡通 刷卡 插卡 打卡 卡车 卡顿 借记 记住 记得 记录 记者 日记 登记 笔记
+账单 账目 记账 结账 转账 户外 用户 客户 住户 开户名 户主
+高峰 高峰期 高速 高兴 高级 高度 提高 最高 高中 高考
+王府 王府井 王国 国王 张家界 张家口 李子 李白 李宁 杜鹃 杜鹃花 金华 金华火腿 黄山 黄河 黄金 白云 白天 白色 明白 明天 明年 明星 明显 说明
+刚才 刚刚 刚好 方才 才能 人才 天才 口才 文明 光明 黎明 清明 发明 分明 高明 聪明 英明 精明
+我们 你们 他们 她们 它们 咱们 自己 大家 别人 人们 什么 怎么 怎样 为什么 这个 那个 这些 那些 这里 那里 哪里 这样 那样 如果 因为 所以 但是 而且 或者 还是 已经 正在 可以 应该 需要 必须 能够 可能 就是 只是 还有 没有 不是 虽然 然后 现在 以后 以前 之前 之后 时候 时间 今天 昨天 一起 一下 一些 一点 一直 一定 一样 一般 一个 所有 每个 其他 其中 非常 特别 比较 马上 立即 目前
+工作 公司 单位 部门 员工 经理 老板 同事 客服 服务 业务 产品 项目 管理 平台 网站 网络 网页 应用 软件 程序 数据 文件 资料 查询 查看 修改 删除 添加 提交 填写 输入 输出 注册 登录 退出 授权 审核 申请 办理 处理 确认 通知 消息 发送 接收 回复 咨询 投诉 反馈 售后
+订单 下单 付款 支付 收款 退款 发货 收货 快递 物流 配送 包裹 商品 价格 金额 费用 余额 充值 提现 交易 购买 出售 优惠 发票 合同 协议
+学生 学校 老师 班级 家长 孩子 父亲 母亲 爸爸 妈妈 儿子 女儿 哥哥 姐姐 弟弟 妹妹 朋友 先生 女士 小姐 同学 家人 家庭 家里 医院 医生 病人 患者
+中国 北京 上海 广州 深圳 天津 重庆 杭州 南京 武汉 成都 西安 省份 城市 区县 街道 小区 大厦 广场 单元 号楼 门牌 门牌号 社区 村庄 乡镇
+`
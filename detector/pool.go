@@ -0,0 +1,42 @@
+package detector
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/bytedance/godlp/dlpheader"
+)
+
+// resultPool holds DetectResult objects of RESULT_MODE_POOLED, which are released by ReleaseResult
+var resultPool = sync.Pool{
+	New: func() interface{} {
+		return new(dlpheader.DetectResult)
+	},
+}
+
+// public func
+
+// ReleaseResult puts res back into pool, res must not be used after release
+func ReleaseResult(res *dlpheader.DetectResult) {
+	if res == nil {
+		return
+	}
+	*res = dlpheader.DetectResult{}
+	resultPool.Put(res)
+}
+
+// ReleaseResults puts results back into pool, results must not be used after release
+func ReleaseResults(results []*dlpheader.DetectResult) {
+	for i, res := range results {
+		ReleaseResult(res)
+		results[i] = nil
+	}
+}
+
+// private func
+
+// bytesToString converts bytes to string without copy
+func bytesToString(b []byte) string {
+	/* #nosec G103 */
+	return *(*string)(unsafe.Pointer(&b))
+}
@@ -0,0 +1,177 @@
+package detector
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	DEF_SEGMENT_RANGE = 16 // max CJK chars on each side of a word which are segmented
+)
+
+// segmenter splits CJK text into words by bidirectional maximum matching over a lexicon
+type segmenter struct {
+	words  map[string]struct{}
+	maxLen int // max length of word in chars
+}
+
+var (
+	defSegmenter     *segmenter
+	defSegmenterOnce sync.Once
+)
+
+// private func
+
+// getDefSegmenter returns segmenter of the bundled lexicon, which is loaded on first use
+func getDefSegmenter() *segmenter {
+	defSegmenterOnce.Do(func() {
+		defSegmenter = newSegmenter(strings.Fields(defLexicon))
+	})
+	return defSegmenter
+}
+
+// newSegmenter creates segmenter from word list, words which are shorter than 2 chars are skipped
+func newSegmenter(wordList []string) *segmenter {
+	seg := &segmenter{words: make(map[string]struct{}, len(wordList))}
+	for _, word := range wordList {
+		if sz := utf8.RuneCountInString(word); sz >= 2 {
+			seg.words[word] = struct{}{}
+			if sz > seg.maxLen {
+				seg.maxLen = sz
+			}
+		}
+	}
+	return seg
+}
+
+// prepareSegment collects CJK words of VDict, BDict and CDict, so that they are words of segmentation besides the bundled lexicon
+func (I *Detector) prepareSegment() {
+	I.segWords = nil
+	if !I.Segment {
+		return
+	}
+	wordList := make([]string, 0, DEF_RESULT_SIZE)
+	for _, dict := range [][]string{I.VDict, I.BDict, I.CDict} {
+		for _, word := range dict {
+			if r, _ := utf8.DecodeRuneInString(word); isCJK(r) {
+				wordList = append(wordList, strings.ToLower(word))
+			}
+		}
+	}
+	I.segWords = newSegmenter(wordList)
+}
+
+// isSegmentWord checks whether in[pos:end] is not split by segmentation of CJK text around it
+// CJK text is segmented at most DEF_SEGMENT_RANGE chars on each side
+func (I *Detector) isSegmentWord(in []byte, pos int, end int) bool {
+	st := pos
+	for n := 0; n < DEF_SEGMENT_RANGE; n++ {
+		r, sz := utf8.DecodeLastRune(in[:st])
+		if sz == 0 || !isCJK(r) {
+			break
+		}
+		st -= sz
+	}
+	ed := end
+	for n := 0; n < DEF_SEGMENT_RANGE; n++ {
+		r, sz := utf8.DecodeRune(in[ed:])
+		if sz == 0 || !isCJK(r) {
+			break
+		}
+		ed += sz
+	}
+	if st == pos && ed == end {
+		return true
+	}
+	text := []rune(string(in[st:ed]))
+	left := utf8.RuneCount(in[st:pos])
+	right := left + utf8.RuneCount(in[pos:end])
+	cuts := I.segment(text)
+	return cuts[left] && cuts[right]
+}
+
+// segment returns cut positions of text, cuts[i] is true if a word starts or ends at text[i]
+// both forward and backward maximum matching are used, the one with fewer words wins, then fewer single chars, then backward
+func (I *Detector) segment(text []rune) []bool {
+	forward := I.forwardMatch(text)
+	backward := I.backwardMatch(text)
+	best := backward
+	if len(forward) < len(backward) || (len(forward) == len(backward) && countSingle(forward) < countSingle(backward)) {
+		best = forward
+	}
+	cuts := make([]bool, len(text)+1)
+	cuts[0] = true
+	pos := 0
+	for _, sz := range best {
+		pos += sz
+		cuts[pos] = true
+	}
+	return cuts
+}
+
+// forwardMatch returns lengths of words from left to right
+func (I *Detector) forwardMatch(text []rune) []int {
+	ret := make([]int, 0, len(text))
+	for i := 0; i < len(text); {
+		sz := I.matchLen(text[i:], true)
+		ret = append(ret, sz)
+		i += sz
+	}
+	return ret
+}
+
+// backwardMatch returns lengths of words from left to right, words are matched from right to left
+func (I *Detector) backwardMatch(text []rune) []int {
+	ret := make([]int, 0, len(text))
+	for j := len(text); j > 0; {
+		sz := I.matchLen(text[:j], false)
+		ret = append(ret, sz)
+		j -= sz
+	}
+	for i, j := 0, len(ret)-1; i < j; i, j = i+1, j-1 {
+		ret[i], ret[j] = ret[j], ret[i]
+	}
+	return ret
+}
+
+// matchLen returns length of the longest word at the head of text if forward, otherwise at the tail, 1 if there is no word
+func (I *Detector) matchLen(text []rune, forward bool) int {
+	def := getDefSegmenter()
+	maxLen := def.maxLen
+	if I.segWords != nil && I.segWords.maxLen > maxLen {
+		maxLen = I.segWords.maxLen
+	}
+	if maxLen > len(text) {
+		maxLen = len(text)
+	}
+	for sz := maxLen; sz >= 2; sz-- {
+		var word string
+		if forward {
+			word = string(text[:sz])
+		} else {
+			word = string(text[len(text)-sz:])
+		}
+		if def.has(word) || (I.segWords != nil && I.segWords.has(word)) {
+			return sz
+		}
+	}
+	return 1
+}
+
+// has checks whether word is in lexicon
+func (I *segmenter) has(word string) bool {
+	_, ok := I.words[word]
+	return ok
+}
+
+// countSingle returns count of single char words
+func countSingle(lenList []int) int {
+	cnt := 0
+	for _, sz := range lenList {
+		if sz == 1 {
+			cnt++
+		}
+	}
+	return cnt
+}
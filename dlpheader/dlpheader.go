@@ -2,12 +2,13 @@
 package dlpheader
 
 import (
+	"io"
 	"strings"
 )
 
 // DetectResult DataStrcuture. Two kinds of result
 // ResultType: VALUE, returned from Detect() and Deidentify()
-// ResultType: KV, returned from DetectMap(), DetectJSON() and DeidentifyMap()
+// ResultType: KV, returned from DetectMap(), DetectJSON(), DetectXML(), DetectHTML() and DeidentifyMap()
 type DetectResult struct {
 	RuleID     int32  `json:"rule_id"`     // RuleID of rules in conf file
 	Text       string `json:"text"`        // substring which is detected by rule
@@ -15,16 +16,103 @@ type DetectResult struct {
 	ResultType string `json:"result_type"` // VALUE or KV, based on Rule
 	Key        string `json:"key"`         // In ResultType: KV, Key is key of map for path of json object
 	// In ResultType: VALUE mode, DetectResult.Text will be inputText[ByteStart:ByteEnd]
+	// if Global.Preprocess strips or folds chars, Text is the normalized text, and ByteStart:ByteEnd covers the original bytes
 	// In ResultType: KV, DetectResult.Text will be inputMap[DetectResult.Key][ByteStart:ByteEnd]
 	ByteStart int `json:"byte_start"`
 	ByteEnd   int `json:"byte_end"`
+	// In ResultType: VALUE mode, positions in rune, UTF-16 code unit, and Line/Column of ByteStart are filled by Detect()
+	// Line and Column start from 1, Column counts runes, Line 0 means these positions are not filled
+	// In DeidentifyCSV, Line is the row number of record, header row is 1, and Column is the column number
+	// they are always written into JSON, so that offset 0 can be told from not filled by Line
+	RuneStart  int `json:"rune_start"`
+	RuneEnd    int `json:"rune_end"`
+	UTF16Start int `json:"utf16_start"`
+	UTF16End   int `json:"utf16_end"`
+	Line       int `json:"line"`
+	Column     int `json:"column"`
 	// fields are defined in conf file
 	InfoType  string            `json:"info_type"`
 	EnName    string            `json:"en_name"`
 	CnName    string            `json:"cn_name"`
 	GroupName string            `json:"group_name"`
 	Level     string            `json:"level"`
+	Score     int32             `json:"score,omitempty"`
 	ExtInfo   map[string]string `json:"ext_info,omitempty"`
+	// In decoded result, Text is the whole encoded segment, DecodedText is the sensitive text found after decoding
+	// EncodingPath lists decoder names from outer to inner, such as [URL, BASE64]
+	EncodingPath []string `json:"encoding_path,omitempty"`
+	DecodedText  string   `json:"decoded_text,omitempty"`
+	// RFC 6901 JSON Pointer of result in DetectJSON, keys are in original casing, such as /objList/0/uid
+	JSONPointer string `json:"json_pointer,omitempty"`
+	// index of JSON value in DetectJSONStream and DeidentifyJSONStream, starts from 1, 0 means result is not from a stream
+	Record int `json:"record,omitempty"`
+}
+
+// ClassifyResult is aggregate result of Classify and ClassifyJSON
+type ClassifyResult struct {
+	Total         int                 `json:"total"`           // count of results
+	InfoTypeCount map[string]int      `json:"info_type_count"` // InfoType => count of results
+	MaxLevel      string              `json:"max_level"`       // highest Level, empty if nothing is found
+	Groups        map[string][]string `json:"groups"`          // ExtInfo key => distinct sorted values, such as EnGroup => [user_data]
+	HasL4         bool                `json:"has_l4"`          // whether any result is L4
+}
+
+// ColumnClass is result of ClassifyColumns for one column
+type ColumnClass struct {
+	Column    int     `json:"column"`     // index of column, starts from 0
+	Name      string  `json:"name"`       // column name in header, empty if header is not provided
+	InfoType  string  `json:"info_type"`  // dominant InfoType of sampled cells, empty if no cell hits
+	HitRatio  float64 `json:"hit_ratio"`  // ratio of sampled non-empty cells which hit InfoType
+	Level     string  `json:"level"`      // highest Level of results in sampled cells
+	HeaderHit bool    `json:"header_hit"` // column name alone matches key rules of a KV rule
+	Sampled   int     `json:"sampled"`    // number of sampled non-empty cells
+}
+
+// CSVOptions is options of DeidentifyCSV, nil means default options
+type CSVOptions struct {
+	// field delimiter, ',' if it is 0, must be an ASCII char other than quote and newline
+	Comma rune
+	// column name in header => MaskRules.RuleName or registered masker
+	// cells of pinned columns are masked by the rule as a whole without detection, RuleID of their results is 0
+	ColumnMask map[string]string
+}
+
+// BatchResult is the result of an item in batch APIs, such as DetectBatch()
+type BatchResult struct {
+	Output  string          // masked text of item, empty in DetectBatch()
+	Results []*DetectResult // results of item
+	Err     error           // error of item, other items are not affected
+}
+
+// CacheStats is statistics of result cache, see Global.ResultCacheSize
+// statistics are reset when config is applied
+type CacheStats struct {
+	Hits      int64 `json:"hits"`
+	Misses    int64 `json:"misses"`
+	Evictions int64 `json:"evictions"` // entries removed because cache is full
+	Size      int   `json:"size"`      // current entries
+	Capacity  int   `json:"capacity"`  // max entries, 0 means cache is disabled
+}
+
+// ResultSet holds results returned by pooled APIs, such as DetectBytesPooled()
+// it must be released by Release() after use, Results and their Text must not be used after that
+type ResultSet struct {
+	Results []*DetectResult
+	// Buf is the scratch buffer which Text of Results refers to, it is reused after Release()
+	Buf     []byte
+	release func(*ResultSet)
+}
+
+// NewResultSet creates a ResultSet, release is called by Release() to recycle it
+func NewResultSet(release func(*ResultSet)) *ResultSet {
+	return &ResultSet{release: release}
+}
+
+// Release recycles the ResultSet and its results
+func (I *ResultSet) Release() {
+	if I != nil && I.release != nil {
+		I.release(I)
+	}
 }
 
 var (
@@ -93,9 +181,97 @@ type EngineAPI interface {
 	DeidentifyMap(inputMap map[string]string) (map[string]string, []*DetectResult, error)
 
 	// DeidentifyJSON detects JSON firstly, then return masked json object in string formate and results
-	// 对jsonText先识别，然后按规则进行打码，返回打码后的JSON string
+	// key order, whitespace and number literals of jsonText are kept, only masked values are rewritten
+	// 对jsonText先识别，然后按规则进行打码，返回打码后的JSON string，保留原有的key顺序、空白和数字写法
 	DeidentifyJSON(jsonText string) (string, []*DetectResult, error)
 
+	// DetectJSONStream detects JSON values from reader one by one with bounded memory, newline-delimited JSON is supported
+	// 流式识别JSON，支持NDJSON，结果中的Record为记录序号
+	DetectJSONStream(r io.Reader) ([]*DetectResult, error)
+
+	// DeidentifyJSONStream detects JSON values from reader one by one, then writes masked JSON into writer
+	// 流式识别JSON并打码，打码后的JSON写入writer
+	DeidentifyJSONStream(r io.Reader, w io.Writer) ([]*DetectResult, error)
+
+	// DetectXML detects text and attribute values of XML, element and attribute names are keys, Key is XPath-like path
+	// 对XML进行敏感信息识别，元素名和属性名作为key，结果的Key为类似XPath的路径
+	DetectXML(xmlText string) ([]*DetectResult, error)
+
+	// DeidentifyXML detects XML firstly, then return masked XML, only text and attribute values are rewritten
+	// 对XML先识别，然后按规则进行打码，只改写文本和属性值
+	DeidentifyXML(xmlText string) (string, []*DetectResult, error)
+
+	// DetectHTML detects text and attribute values of HTML, content of script and style is skipped
+	// 对HTML进行敏感信息识别，script和style的内容不识别
+	DetectHTML(htmlText string) ([]*DetectResult, error)
+
+	// DeidentifyHTML detects HTML firstly, then return masked HTML, only text and attribute values are rewritten
+	// 对HTML先识别，然后按规则进行打码，只改写文本和属性值
+	DeidentifyHTML(htmlText string) (string, []*DetectResult, error)
+
+	// DeidentifyCSV reads CSV rows from reader, header row provides keys of KV rules, masked rows are written into writer
+	// cells which are not masked are copied as they are, so that quoting is kept
+	// 流式对CSV打码，首行为表头，作为KV规则的key，未打码的单元格原样输出
+	DeidentifyCSV(r io.Reader, w io.Writer, opts *CSVOptions) ([]*DetectResult, error)
+
+	// ClassifyColumns detects sampled cells of each column, returns dominant InfoType, hit ratio and highest Level per column
+	// sampleSize <= 0 means all rows are sampled, header may be nil
+	// 对表格按列抽样识别，返回每列的主要InfoType、命中比例、最高Level，以及列名本身是否命中KV规则
+	ClassifyColumns(rows [][]string, header []string, sampleSize int) ([]*ColumnClass, error)
+
+	// Classify detects inputText without masking, returns counts per InfoType, highest Level, ExtInfo groups and whether L4 is found
+	// 对string进行识别但不打码，返回各InfoType的数量、最高Level、ExtInfo分组以及是否有L4
+	Classify(inputText string) (*ClassifyResult, error)
+
+	// ClassifyJSON detects jsonText without masking like Classify
+	// 对json string进行识别但不打码，返回汇总结果
+	ClassifyJSON(jsonText string) (*ClassifyResult, error)
+
+	// ContainsSensitive checks whether inputText has a hit whose Level is not lower than minLevel, it stops at the first hit
+	// 判断文本是否包含不低于minLevel的敏感信息，命中即返回，适合准入检查
+	ContainsSensitive(inputText string, minLevel string) (bool, error)
+
+	// DetectBytes works like Detect for bytes, it is not zero-copy, src is copied once and Text of results refers to the copy
+	// 对[]byte进行敏感信息识别，并非零拷贝，src整体拷贝一次，结果的Text不再单独分配
+	DetectBytes(src []byte) ([]*DetectResult, error)
+
+	// DeidentifyBytes works like Deidentify for bytes, masked text is appended into dst and returned
+	// 对[]byte先识别再打码，打码结果追加到dst并返回，dst可复用以减少内存分配
+	DeidentifyBytes(dst []byte, src []byte) ([]byte, []*DetectResult, error)
+
+	// DetectBytesPooled works like DetectBytes, but results and buffer are taken from pool, ResultSet must be released after use
+	// 与DetectBytes相同，但结果对象和缓冲区来自对象池，使用完后必须调用ResultSet.Release()
+	DetectBytesPooled(src []byte) (*ResultSet, error)
+
+	// DeidentifyBytesPooled works like DeidentifyBytes, but results and buffer are taken from pool, ResultSet must be released after use
+	// 与DeidentifyBytes相同，但结果对象和缓冲区来自对象池，使用完后必须调用ResultSet.Release()
+	DeidentifyBytesPooled(dst []byte, src []byte) ([]byte, *ResultSet, error)
+
+	// GetCacheStats returns hit and miss statistics of result cache, which is enabled by Global.ResultCacheSize
+	// 返回结果缓存的命中统计，缓存由 Global.ResultCacheSize 开启，配置或规则变化后缓存自动失效
+	GetCacheStats() CacheStats
+
+	// DetectBatch detects each item like Detect by Global.BatchWorkers goroutines, output is in input order
+	// 并发批量识别，worker数量由 Global.BatchWorkers 配置，结果与输入顺序一致，每项单独返回错误
+	DetectBatch(inputList []string) ([]*BatchResult, error)
+
+	// DeidentifyBatch deidentifies each item like Deidentify by Global.BatchWorkers goroutines, output is in input order
+	// 并发批量打码，结果与输入顺序一致，每项单独返回错误
+	DeidentifyBatch(inputList []string) ([]*BatchResult, error)
+
+	// DeidentifyJSONBatch deidentifies each item like DeidentifyJSON by Global.BatchWorkers goroutines, output is in input order
+	// 并发批量对JSON打码，结果与输入顺序一致，每项单独返回错误
+	DeidentifyJSONBatch(jsonList []string) ([]*BatchResult, error)
+
+	// DetectEncoded detects input in encoding, such as GBK, GB18030 and UTF-16, empty encoding means detecting it
+	// ByteStart and ByteEnd of results are offsets in input, Text is UTF-8, the encoding used is returned
+	// 对GBK、GB18030、UTF-16等编码的输入进行识别，encoding为空时自动检测，结果的偏移为原编码中的字节偏移
+	DetectEncoded(input []byte, encoding string) ([]*DetectResult, string, error)
+
+	// DeidentifyEncoded detects input in encoding like DetectEncoded, then returns masked input in the same encoding
+	// 对GBK、GB18030、UTF-16等编码的输入先识别再打码，输出保持原编码
+	DeidentifyEncoded(input []byte, encoding string) ([]byte, []*DetectResult, string, error)
+
 	// ShowResults print results in console
 	// 打印识别结果
 	ShowResults(resultArray []*DetectResult)
@@ -125,6 +301,14 @@ type EngineAPI interface {
 	// 注册自定义打码函数
 	RegisterMasker(maskName string, maskFunc func(string) (string, error)) error
 
+	// Register DIY Decoder, segReg is regex for finding encoded segments, decodeFunc decodes a segment
+	// 注册自定义解码函数，segReg 用于查找编码片段
+	RegisterDecoder(decoderName string, segReg string, decodeFunc func([]byte) ([]byte, error)) error
+
+	// Register DIY KV Extractor, extractFunc returns position list of KV items in line, each position is [keyStart, keyEnd, valueStart, valueEnd)
+	// 注册自定义KV提取函数，提取出的KV会用于KV规则的识别
+	RegisterExtractor(extractorName string, extractFunc func(line []byte) [][]int) error
+
 	// ApplyConfigDefault will use embeded local config, only used for DLP team
 	// 业务禁止使用
 	ApplyConfigDefault() error
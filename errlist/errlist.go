@@ -33,4 +33,18 @@ var (
 	ERR_MASK_STRUCT_INPUT      = errors.New("[DLP] input of MaskStruct must be a pointer of a strcut")
 	ERR_MASK_STRUCT_OUTPUT     = errors.New("[DLP] Internal Error of MaskStruct, output is nil")
 	ERR_ONLY_FOR_LOG           = errors.New("[DLP] NewLogProcessor() has been called. engine can be only used for log")
+	ERR_DECODER_NOTFOUND       = errors.New("[DLP] decoder not found")
+	ERR_DECODER_FUNC_EMPTY     = errors.New("[DLP] decode function is nil")
+	ERR_DECODER_NAME_CONFLICT  = errors.New("[DLP] decoder name conflicts with loaded decoders")
+	ERR_EXTRACTOR_NOTFOUND     = errors.New("[DLP] extractor not found")
+	ERR_EXTRACTOR_FUNC_EMPTY   = errors.New("[DLP] extract function is nil")
+	ERR_EXTRACTOR_CONFLICT     = errors.New("[DLP] extractor name conflicts with loaded extractors")
+	ERR_JSON_TRAILING_DATA     = errors.New("[DLP] invalid JSON, data after top-level value")
+	ERR_JSON_INVALID           = errors.New("[DLP] invalid JSON token")
+	ERR_MARKUP_INVALID         = errors.New("[DLP] invalid XML or HTML markup")
+	ERR_CSV_INVALID_COMMA      = errors.New("[DLP] CSV comma must be an ASCII char other than quote and newline")
+	ERR_ENCODING_NOT_SUPPORT   = errors.New("[DLP] encoding is not supported, use one of UTF-8, GBK, GB18030, UTF-16, UTF-16LE, UTF-16BE")
+	ERR_DECODE_NOT_PRINTABLE   = errors.New("[DLP] decoded bytes are not printable text")
+	ERR_DECODE_NOT_ENCODED     = errors.New("[DLP] segment does not look encoded")
+	ERR_LEVEL_INVALID          = errors.New("[DLP] level is invalid, use one of L1, L2, L3, L4")
 )
@@ -0,0 +1,258 @@
+// Package extractor implements extractor functions, which extract KV items from a line for KV rules
+package extractor
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/bytedance/godlp/detector"
+	"github.com/bytedance/godlp/errlist"
+)
+
+const (
+	EXTRACTOR_QUERY  = "QUERY"
+	EXTRACTOR_COOKIE = "COOKIE"
+	EXTRACTOR_HEADER = "HEADER"
+	EXTRACTOR_LOGFMT = "LOGFMT"
+	EXTRACTOR_STRUCT = "STRUCT"
+)
+
+// regex for built-in extractors
+var (
+	// a=1&b=2, ?a=1
+	queryReg = regexp.MustCompile(`\??[A-Za-z0-9_.\-\[\]%]+=[^&\s#"'<>]*(?:&[A-Za-z0-9_.\-\[\]%]+=[^&\s#"'<>]*)*`)
+	// Cookie: a=1; b=2
+	cookieReg = regexp.MustCompile(`(?i)\b(?:set-)?cookie\s*:\s*`)
+	// X-User-Id: 123
+	headerReg = regexp.MustCompile(`^\s*([A-Za-z][A-Za-z0-9\-]*)\s*:\s*(\S(?:.*\S)?)\s*$`)
+	// a=1 b="x y"
+	logfmtReg = regexp.MustCompile(`(?:^|\s)([A-Za-z_][A-Za-z0-9_.\-]*)=("(?:[^"\\]|\\.)*"|[^\s"]*)`)
+	// {Name:foo Phone:186}
+	structReg = regexp.MustCompile(`[{\s]([A-Za-z_][A-Za-z0-9_]*):([^\s{}]+)`)
+)
+
+// headerNameSet holds names of HTTP headers whose value is the rest of line, X-* headers are also accepted
+var headerNameSet = map[string]struct{}{
+	"accept": {}, "accept-charset": {}, "accept-encoding": {}, "accept-language": {}, "authorization": {},
+	"cache-control": {}, "connection": {}, "content-disposition": {}, "content-encoding": {}, "content-length": {},
+	"content-type": {}, "date": {}, "etag": {}, "expires": {}, "forwarded": {}, "from": {}, "host": {},
+	"if-match": {}, "if-modified-since": {}, "if-none-match": {}, "last-modified": {}, "location": {},
+	"origin": {}, "pragma": {}, "proxy-authorization": {}, "referer": {}, "server": {}, "user-agent": {},
+	"via": {}, "www-authenticate": {},
+}
+
+// ExtractFunc returns position list of KV items in line, each position is [keyStart, keyEnd, valueStart, valueEnd)
+type ExtractFunc func(line []byte) [][]int
+
+type Extractor struct {
+	name        string
+	extractFunc ExtractFunc
+}
+
+type ExtractorAPI interface {
+	// GetName returns name of extractor
+	GetName() string
+	// Extract returns KV items in line, Key is lower case, Start and End are position of Value in line
+	Extract(line []byte) []*detector.KVItem
+}
+
+// BuiltinNames returns names of built-in extractors, ordered from the most specific format
+func BuiltinNames() []string {
+	return []string{EXTRACTOR_QUERY, EXTRACTOR_COOKIE, EXTRACTOR_STRUCT, EXTRACTOR_LOGFMT, EXTRACTOR_HEADER}
+}
+
+// NewExtractor creates extractor object by extract function
+func NewExtractor(name string, extractFunc ExtractFunc) (ExtractorAPI, error) {
+	if extractFunc == nil {
+		return nil, errlist.ERR_EXTRACTOR_FUNC_EMPTY
+	}
+	obj := new(Extractor)
+	obj.name = name
+	obj.extractFunc = extractFunc
+	return obj, nil
+}
+
+// NewBuiltinExtractor creates built-in extractor object by name, one of [QUERY, COOKIE, STRUCT, LOGFMT, HEADER]
+func NewBuiltinExtractor(name string) (ExtractorAPI, error) {
+	switch name {
+	case EXTRACTOR_QUERY:
+		return NewExtractor(name, extractQuery)
+	case EXTRACTOR_COOKIE:
+		return NewExtractor(name, extractCookie)
+	case EXTRACTOR_HEADER:
+		return NewExtractor(name, extractHeader)
+	case EXTRACTOR_LOGFMT:
+		return NewExtractor(name, extractLogfmt)
+	case EXTRACTOR_STRUCT:
+		return NewExtractor(name, extractStruct)
+	default:
+		return nil, errlist.ERR_EXTRACTOR_NOTFOUND
+	}
+}
+
+// public func
+
+// GetName returns name of extractor
+func (I *Extractor) GetName() string {
+	return I.name
+}
+
+// Extract returns KV items in line, invalid positions are ignored
+func (I *Extractor) Extract(line []byte) []*detector.KVItem {
+	posList := I.extractFunc(line)
+	kvList := make([]*detector.KVItem, 0, len(posList))
+	sz := len(line)
+	for _, pos := range posList {
+		if len(pos) != 4 || pos[0] < 0 || pos[0] >= pos[1] || pos[1] > sz || pos[2] < 0 || pos[2] >= pos[3] || pos[3] > sz {
+			continue
+		}
+		kvList = append(kvList, &detector.KVItem{
+			Key:   strings.ToLower(string(line[pos[0]:pos[1]])),
+			Value: string(line[pos[2]:pos[3]]),
+			Start: pos[2],
+			End:   pos[3],
+		})
+	}
+	return kvList
+}
+
+// private func
+
+// extractQuery extracts URL query string, such as ?a=1 or a=1&b=2
+func extractQuery(line []byte) [][]int {
+	ret := make([][]int, 0)
+	if bytes.IndexByte(line, '=') == -1 {
+		return ret
+	}
+	for _, loc := range queryReg.FindAllIndex(line, -1) {
+		st, ed := loc[0], loc[1]
+		if line[st] == '?' {
+			st++
+		} else if bytes.IndexByte(line[st:ed], '&') == -1 { // single pair without '?' is not a query string
+			continue
+		}
+		ret = append(ret, splitPairs(line, st, ed, '&')...)
+	}
+	return ret
+}
+
+// extractCookie extracts Cookie and Set-Cookie header, such as Cookie: a=1; b=2
+func extractCookie(line []byte) [][]int {
+	ret := make([][]int, 0)
+	for _, loc := range cookieReg.FindAllIndex(line, -1) {
+		st := loc[1]
+		ed := len(line)
+		if i := bytes.IndexAny(line[st:], "\r\n\"'"); i != -1 {
+			ed = st + i
+		}
+		ret = append(ret, splitPairs(line, st, ed, ';')...)
+	}
+	return ret
+}
+
+// extractHeader extracts HTTP header line, such as X-User-Id: 123
+// value of key which is not a HTTP header name ends at the first white space, so that ordinary text such as Uid: 123 abc is not taken
+// value with nested KV is ignored, so that it is left to other extractors
+func extractHeader(line []byte) [][]int {
+	ret := make([][]int, 0)
+	line = bytes.TrimRight(line, "\r\n")
+	loc := headerReg.FindSubmatchIndex(line)
+	if loc == nil {
+		return ret
+	}
+	vSt, vEd := loc[4], loc[5]
+	if !isHeaderName(line[loc[2]:loc[3]]) {
+		if i := bytes.IndexAny(line[vSt:vEd], " \t"); i != -1 {
+			vEd = vSt + i
+		}
+	}
+	if bytes.IndexAny(line[vSt:vEd], "=:{[\"") == -1 {
+		ret = append(ret, []int{loc[2], loc[3], vSt, vEd})
+	}
+	return ret
+}
+
+// isHeaderName checks whether name is a HTTP header name in headerNameSet or starts with X-
+func isHeaderName(name []byte) bool {
+	lower := strings.ToLower(string(name))
+	if strings.HasPrefix(lower, "x-") {
+		return true
+	}
+	_, ok := headerNameSet[lower]
+	return ok
+}
+
+// extractLogfmt extracts logfmt line, such as a=1 b="x y", at least two pairs are needed
+func extractLogfmt(line []byte) [][]int {
+	ret := make([][]int, 0)
+	if bytes.IndexByte(line, '=') == -1 {
+		return ret
+	}
+	locs := logfmtReg.FindAllSubmatchIndex(line, -1)
+	if len(locs) < 2 {
+		return ret
+	}
+	for _, loc := range locs {
+		vSt, vEd := loc[4], loc[5]
+		if vEd-vSt >= 2 && line[vSt] == '"' { // quoted value
+			vSt++
+			vEd--
+		}
+		ret = append(ret, []int{loc[2], loc[3], vSt, vEd})
+	}
+	return ret
+}
+
+// extractStruct extracts fields of go struct which is printed by %+v, such as {Name:foo Phone:186}
+func extractStruct(line []byte) [][]int {
+	ret := make([][]int, 0)
+	if bytes.IndexByte(line, '{') == -1 {
+		return ret
+	}
+	// depth[i] is the brace depth before line[i]
+	depth := make([]int, len(line)+1)
+	for i, ch := range line {
+		depth[i+1] = depth[i]
+		if ch == '{' {
+			depth[i+1]++
+		} else if ch == '}' && depth[i] > 0 {
+			depth[i+1]--
+		}
+	}
+	for _, loc := range structReg.FindAllSubmatchIndex(line, -1) {
+		if depth[loc[2]] > 0 {
+			ret = append(ret, []int{loc[2], loc[3], loc[4], loc[5]})
+		}
+	}
+	return ret
+}
+
+// splitPairs splits line[st:ed] by sep, each part is key=value
+func splitPairs(line []byte, st int, ed int, sep byte) [][]int {
+	ret := make([][]int, 0)
+	for st < ed {
+		partEd := ed
+		if i := bytes.IndexByte(line[st:ed], sep); i != -1 {
+			partEd = st + i
+		}
+		if eq := bytes.IndexByte(line[st:partEd], '='); eq != -1 {
+			kSt, kEd := trimSpace(line, st, st+eq)
+			vSt, vEd := trimSpace(line, st+eq+1, partEd)
+			ret = append(ret, []int{kSt, kEd, vSt, vEd})
+		}
+		st = partEd + 1
+	}
+	return ret
+}
+
+// trimSpace returns position of line[st:ed] without leading and trailing space
+func trimSpace(line []byte, st int, ed int) (int, int) {
+	for st < ed && (line[st] == ' ' || line[st] == '\t') {
+		st++
+	}
+	for ed > st && (line[ed-1] == ' ' || line[ed-1] == '\t') {
+		ed--
+	}
+	return st, ed
+}
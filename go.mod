@@ -2,4 +2,7 @@ module github.com/bytedance/godlp
 
 go 1.14
 
-require gopkg.in/yaml.v2 v2.4.0
+require (
+	golang.org/x/text v0.3.6
+	gopkg.in/yaml.v2 v2.4.0
+)
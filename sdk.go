@@ -9,8 +9,10 @@ import (
 	"unsafe"
 
 	"github.com/bytedance/godlp/conf"
+	"github.com/bytedance/godlp/decoder"
 	"github.com/bytedance/godlp/detector"
 	"github.com/bytedance/godlp/dlpheader"
+	"github.com/bytedance/godlp/extractor"
 	"github.com/bytedance/godlp/mask"
 	"gopkg.in/yaml.v2"
 )
@@ -44,6 +46,13 @@ const (
 	DEF_MAX_CALL_DEEP = 5                                // max call depth for MaskStruct
 )
 
+// const var for Global.JSONNumberMask
+const (
+	JSON_NUMBER_MASK_STRING    = "STRING"    // masked number is written back as string
+	JSON_NUMBER_MASK_KEEP_TYPE = "KEEP_TYPE" // masked number is still a number if possible, masked digits are replaced by Global.JSONMaskDigit
+	DEF_JSON_MASK_DIGIT        = '9'         // default Global.JSONMaskDigit
+)
+
 var (
 	DEF_MAX_LOG_INPUT     int32 = 1024 // default 1KB, the max input lenght for log, change it in conf
 	DEF_MAX_REGEX_RULE_ID int32 = 0    // default 0, no regex rule will be used for log default, change it in conf
@@ -63,6 +72,17 @@ type Engine struct {
 	confObj     *conf.DlpConf
 	detectorMap map[int32]detector.DetectorAPI
 	maskerMap   map[string]mask.MaskAPI
+	decoderList []decoder.DecoderAPI // decoders used by Detect(), in registration order
+	// extractors used by Detect() besides k:v k=v, in registration order
+	extractorList []extractor.ExtractorAPI
+	// RuleID list ordered by cost of detector, used by ContainsSensitive()
+	detectorOrder []int32
+	// version of ruleset, it is increased when config, rules, maskers, decoders or extractors are changed
+	// it is accessed atomically, uint32 needs no 64-bit alignment on 32-bit platforms
+	rulesetVersion uint32
+	resultCache    *resultCache // LRU cache of results, nil if Global.ResultCacheSize is 0
+	stripInvisible bool         // Global.Preprocess has INVISIBLE
+	foldConfusable bool         // Global.Preprocess has CONFUSABLE
 }
 
 // NewEngine creates an Engine Object,不要放在循环中调用
@@ -101,6 +121,10 @@ func (I *Engine) Close() {
 		}
 	}
 	I.detectorMap = nil
+	I.decoderList = nil
+	I.extractorList = nil
+	I.detectorOrder = nil
+	I.resultCache = nil
 	I.confObj = nil
 	I.isClosed = true
 }
@@ -214,6 +238,7 @@ func (I *Engine) DisableAllRules() error {
 	for i, _ := range I.detectorMap {
 		I.detectorMap[i] = nil
 	}
+	I.changeRuleset()
 	return nil
 }
 
@@ -0,0 +1,104 @@
+// Package dlp sdkbatch.go implements batch APIs, items are processed by workers which share the Engine
+package dlp
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/bytedance/godlp/dlpheader"
+	"github.com/bytedance/godlp/errlist"
+)
+
+// public func
+
+// DetectBatch detects each item like Detect by Global.BatchWorkers goroutines, output is in input order
+// 并发批量识别，worker数量由 Global.BatchWorkers 配置，结果与输入顺序一致，每项单独返回错误
+func (I *Engine) DetectBatch(inputList []string) (retList []*dlpheader.BatchResult, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	retList = I.batchImpl(len(inputList), func(i int, item *dlpheader.BatchResult) {
+		item.Results, item.Err = I.Detect(inputList[i])
+	})
+	return
+}
+
+// DeidentifyBatch deidentifies each item like Deidentify by Global.BatchWorkers goroutines, output is in input order
+// 并发批量打码，结果与输入顺序一致，每项单独返回错误
+func (I *Engine) DeidentifyBatch(inputList []string) (retList []*dlpheader.BatchResult, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	retList = I.batchImpl(len(inputList), func(i int, item *dlpheader.BatchResult) {
+		item.Output, item.Results, item.Err = I.Deidentify(inputList[i])
+	})
+	return
+}
+
+// DeidentifyJSONBatch deidentifies each item like DeidentifyJSON by Global.BatchWorkers goroutines, output is in input order
+// 并发批量对JSON打码，结果与输入顺序一致，每项单独返回错误
+func (I *Engine) DeidentifyJSONBatch(jsonList []string) (retList []*dlpheader.BatchResult, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	retList = I.batchImpl(len(jsonList), func(i int, item *dlpheader.BatchResult) {
+		item.Output, item.Results, item.Err = I.DeidentifyJSON(jsonList[i])
+	})
+	return
+}
+
+// private func
+
+// batchImpl calls process for each index by workers, workers take the next index one by one,
+// so that a long item does not block others
+func (I *Engine) batchImpl(sz int, process func(i int, item *dlpheader.BatchResult)) []*dlpheader.BatchResult {
+	retList := make([]*dlpheader.BatchResult, sz)
+	for i := range retList {
+		retList[i] = new(dlpheader.BatchResult)
+	}
+	workers := I.batchWorkers()
+	if workers > sz {
+		workers = sz
+	}
+	var next int64 = -1
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= sz {
+					return
+				}
+				process(i, retList[i])
+			}
+		}()
+	}
+	wg.Wait()
+	return retList
+}
+
+// batchWorkers returns Global.BatchWorkers, GOMAXPROCS if it is 0
+func (I *Engine) batchWorkers() int {
+	if workers := int(I.confObj.Global.BatchWorkers); workers > 0 {
+		return workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
@@ -0,0 +1,135 @@
+// Package dlp sdkbytes.go implements detect and deidentify APIs for bytes, which reuse caller buffers and pooled results
+package dlp
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/bytedance/godlp/detector"
+	"github.com/bytedance/godlp/dlpheader"
+	"github.com/bytedance/godlp/errlist"
+)
+
+// resultSetPool holds ResultSet objects, buffer and results slice of them are reused
+// New is set in init(), because releaseResultSet refers to resultSetPool
+var resultSetPool sync.Pool
+
+func init() {
+	resultSetPool.New = func() interface{} {
+		return dlpheader.NewResultSet(releaseResultSet)
+	}
+}
+
+// public func
+
+// DetectBytes detects src like Detect, it is not zero-copy, src is copied once because detectPre modifies lines in place
+// Text of results refers to the copy
+// 对[]byte进行敏感信息识别，并非零拷贝，src整体拷贝一次，结果的Text不再单独分配
+func (I *Engine) DetectBytes(src []byte) (retResults []*dlpheader.DetectResult, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	if len(src) > DEF_MAX_INPUT {
+		return nil, fmt.Errorf("DEF_MAX_INPUT: %d , %w", DEF_MAX_INPUT, errlist.ERR_MAX_INPUT_LIMIT)
+	}
+	retResults = I.detectBytesImpl(src, append([]byte(nil), src...), detector.RESULT_MODE_NOCOPY, make([]*dlpheader.DetectResult, 0, DEF_RESULT_SIZE))
+	return
+}
+
+// DeidentifyBytes detects src like Deidentify, then appends masked text into dst and returns it, src is copied once like DetectBytes
+// 对[]byte先识别再打码，打码结果追加到dst并返回，dst可复用以减少内存分配
+func (I *Engine) DeidentifyBytes(dst []byte, src []byte) (outBytes []byte, retResults []*dlpheader.DetectResult, retErr error) {
+	defer I.recoveryImpl()
+
+	outBytes = dst
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return dst, nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	if I.isOnlyForLog() {
+		return append(dst, src...), nil, errlist.ERR_ONLY_FOR_LOG
+	}
+	if len(src) > DEF_MAX_INPUT {
+		return append(dst, src...), nil, fmt.Errorf("DEF_MAX_INPUT: %d , %w", DEF_MAX_INPUT, errlist.ERR_MAX_INPUT_LIMIT)
+	}
+	retResults = I.detectBytesImpl(src, append([]byte(nil), src...), detector.RESULT_MODE_NOCOPY, make([]*dlpheader.DetectResult, 0, DEF_RESULT_SIZE))
+	outBytes = I.appendByResult(dst, src, I.resultsForDeidentify(retResults))
+	return
+}
+
+// DetectBytesPooled works like DetectBytes, but results and buffer are taken from pool
+// ResultSet must be released after use, Results and their Text must not be used after that
+// 与DetectBytes相同，但结果对象和缓冲区来自对象池，使用完后必须调用ResultSet.Release()
+func (I *Engine) DetectBytesPooled(src []byte) (retSet *dlpheader.ResultSet, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	if len(src) > DEF_MAX_INPUT {
+		return nil, fmt.Errorf("DEF_MAX_INPUT: %d , %w", DEF_MAX_INPUT, errlist.ERR_MAX_INPUT_LIMIT)
+	}
+	retSet = I.detectPooledImpl(src)
+	return
+}
+
+// DeidentifyBytesPooled works like DeidentifyBytes, but results and buffer are taken from pool
+// ResultSet must be released after use, Results and their Text must not be used after that
+// 与DeidentifyBytes相同，但结果对象和缓冲区来自对象池，使用完后必须调用ResultSet.Release()
+func (I *Engine) DeidentifyBytesPooled(dst []byte, src []byte) (outBytes []byte, retSet *dlpheader.ResultSet, retErr error) {
+	defer I.recoveryImpl()
+
+	outBytes = dst
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return dst, nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	if I.isOnlyForLog() {
+		return append(dst, src...), nil, errlist.ERR_ONLY_FOR_LOG
+	}
+	if len(src) > DEF_MAX_INPUT {
+		return append(dst, src...), nil, fmt.Errorf("DEF_MAX_INPUT: %d , %w", DEF_MAX_INPUT, errlist.ERR_MAX_INPUT_LIMIT)
+	}
+	retSet = I.detectPooledImpl(src)
+	outBytes = I.appendByResult(dst, src, I.resultsForDeidentify(retSet.Results))
+	return
+}
+
+// private func
+
+// detectBytesImpl detects src, buf is a copy of src which is modified by detectPre, Text of results refers to buf
+func (I *Engine) detectBytesImpl(src []byte, buf []byte, mode int, results []*dlpheader.DetectResult) []*dlpheader.DetectResult {
+	inputText := B2S(src)
+	results = I.detectBufNoMask(inputText, buf, mode, results)
+	results = I.detectPost(results, 0)
+	I.fillPosition(inputText, results)
+	return results
+}
+
+// detectPooledImpl detects src into a ResultSet from pool
+func (I *Engine) detectPooledImpl(src []byte) *dlpheader.ResultSet {
+	rs := resultSetPool.Get().(*dlpheader.ResultSet)
+	rs.Buf = append(rs.Buf[:0], src...)
+	rs.Results = I.detectBytesImpl(src, rs.Buf, detector.RESULT_MODE_POOLED, rs.Results[:0])
+	return rs
+}
+
+// releaseResultSet puts results and the ResultSet back into pool
+func releaseResultSet(rs *dlpheader.ResultSet) {
+	detector.ReleaseResults(rs.Results)
+	rs.Results = rs.Results[:0]
+	rs.Buf = rs.Buf[:0]
+	resultSetPool.Put(rs)
+}
@@ -0,0 +1,170 @@
+// Package dlp sdkcache.go implements LRU cache of results for repeated inputs, see Global.ResultCacheSize
+package dlp
+
+import (
+	"container/list"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/bytedance/godlp/dlpheader"
+)
+
+// API kinds of cache entry, same input of different APIs has different entries
+const (
+	CACHE_DETECT           = 1
+	CACHE_DEIDENTIFY       = 2
+	CACHE_DETECT_JSON      = 3
+	CACHE_DEIDENTIFY_JSON  = 4
+	DEF_MAX_CACHE_INPUT    = 1024 * 16 // input longer than 16KB will not be cached
+	DEF_CACHE_RESULT_COUNT = 64        // input which has more results will not be cached
+)
+
+// resultCache is a bounded LRU cache, entry of old ruleset version is removed when it is found
+type resultCache struct {
+	mu       sync.Mutex
+	capacity int
+	lru      *list.List // front is the most recently used
+	itemMap  map[cacheKey]*list.Element
+	stats    dlpheader.CacheStats
+}
+
+// cacheKey is hash of input with API kind, input is compared on hit so that hash collision is harmless
+type cacheKey struct {
+	kind int
+	hash uint64
+}
+
+// cacheEntry holds copies of results and output of an input
+type cacheEntry struct {
+	key     cacheKey
+	version uint32
+	input   string
+	output  string
+	results []*dlpheader.DetectResult
+}
+
+// public func
+
+// GetCacheStats returns hit and miss statistics of result cache, see Global.ResultCacheSize
+// 返回结果缓存的命中统计，Global.ResultCacheSize 为0时缓存不启用
+func (I *Engine) GetCacheStats() dlpheader.CacheStats {
+	defer I.recoveryImpl()
+	if I.resultCache == nil {
+		return dlpheader.CacheStats{}
+	}
+	return I.resultCache.getStats()
+}
+
+// private func
+
+// loadResultCache creates result cache by Global.ResultCacheSize, cached entries of old config are dropped
+func (I *Engine) loadResultCache() {
+	I.changeRuleset()
+	if size := int(I.confObj.Global.ResultCacheSize); size > 0 {
+		I.resultCache = newResultCache(size)
+	} else {
+		I.resultCache = nil
+	}
+}
+
+// changeRuleset increases ruleset version, so that cached results become stale
+func (I *Engine) changeRuleset() {
+	atomic.AddUint32(&I.rulesetVersion, 1)
+}
+
+// cacheGet returns copies of cached results and output of input
+func (I *Engine) cacheGet(kind int, input string) ([]*dlpheader.DetectResult, string, bool) {
+	if I.resultCache == nil || len(input) > DEF_MAX_CACHE_INPUT {
+		return nil, "", false
+	}
+	return I.resultCache.get(kind, input, atomic.LoadUint32(&I.rulesetVersion))
+}
+
+// cachePut stores copies of results and output of input
+func (I *Engine) cachePut(kind int, input string, output string, results []*dlpheader.DetectResult) {
+	if I.resultCache == nil || len(input) > DEF_MAX_CACHE_INPUT || len(results) > DEF_CACHE_RESULT_COUNT {
+		return
+	}
+	I.resultCache.put(kind, input, output, results, atomic.LoadUint32(&I.rulesetVersion))
+}
+
+// newResultCache creates a resultCache with capacity entries
+func newResultCache(capacity int) *resultCache {
+	return &resultCache{
+		capacity: capacity,
+		lru:      list.New(),
+		itemMap:  make(map[cacheKey]*list.Element, capacity),
+		stats:    dlpheader.CacheStats{Capacity: capacity},
+	}
+}
+
+// get finds entry of input whose version is same, results are copied, because caller may modify them
+func (I *resultCache) get(kind int, input string, version uint32) ([]*dlpheader.DetectResult, string, bool) {
+	key := cacheKey{kind: kind, hash: hashInput(input)}
+	I.mu.Lock()
+	defer I.mu.Unlock()
+	if elem, ok := I.itemMap[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		if entry.version == version && entry.input == input {
+			I.lru.MoveToFront(elem)
+			I.stats.Hits++
+			return copyResults(entry.results), entry.output, true
+		}
+		if entry.version != version { // stale entry of old ruleset
+			I.lru.Remove(elem)
+			delete(I.itemMap, key)
+		}
+	}
+	I.stats.Misses++
+	return nil, "", false
+}
+
+// put stores copies of results, the least recently used entry is evicted if cache is full
+func (I *resultCache) put(kind int, input string, output string, results []*dlpheader.DetectResult, version uint32) {
+	key := cacheKey{kind: kind, hash: hashInput(input)}
+	entry := &cacheEntry{key: key, version: version, input: input, output: output, results: copyResults(results)}
+	I.mu.Lock()
+	defer I.mu.Unlock()
+	if elem, ok := I.itemMap[key]; ok {
+		elem.Value = entry
+		I.lru.MoveToFront(elem)
+		return
+	}
+	I.itemMap[key] = I.lru.PushFront(entry)
+	for I.lru.Len() > I.capacity {
+		last := I.lru.Back()
+		I.lru.Remove(last)
+		delete(I.itemMap, last.Value.(*cacheEntry).key)
+		I.stats.Evictions++
+	}
+}
+
+// getStats returns a copy of stats with current size
+func (I *resultCache) getStats() dlpheader.CacheStats {
+	I.mu.Lock()
+	defer I.mu.Unlock()
+	stats := I.stats
+	stats.Size = I.lru.Len()
+	return stats
+}
+
+// hashInput returns FNV-1a hash of input
+func hashInput(input string) uint64 {
+	h := fnv.New64a()
+	h.Write(S2B(input))
+	return h.Sum64()
+}
+
+// copyResults copies each result, ExtInfo and EncodingPath are shared because they are not modified by DLP
+func copyResults(results []*dlpheader.DetectResult) []*dlpheader.DetectResult {
+	if results == nil {
+		return nil
+	}
+	ret := make([]*dlpheader.DetectResult, len(results))
+	for i, res := range results {
+		item := *res
+		ret[i] = &item
+	}
+	return ret
+}
@@ -0,0 +1,292 @@
+// Package dlp sdkclassify.go implements classify APIs, which aggregate detect results without masking
+// ContainsSensitive is also here, which only answers whether input is sensitive
+package dlp
+
+import (
+	"bufio"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bytedance/godlp/detector"
+	"github.com/bytedance/godlp/dlpheader"
+	"github.com/bytedance/godlp/errlist"
+)
+
+// public func
+
+// ClassifyColumns detects sampled cells of each column, returns dominant InfoType, hit ratio and highest Level per column
+// cells with column name are detected like DetectMap, cells without column name are detected like Detect
+// 对表格按列抽样识别，返回每列的主要InfoType、命中比例、最高Level，以及列名本身是否命中KV规则
+func (I *Engine) ClassifyColumns(rows [][]string, header []string, sampleSize int) (retClasses []*dlpheader.ColumnClass, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	return I.classifyColumnsImpl(rows, header, sampleSize)
+}
+
+// Classify detects inputText without masking, returns aggregate result
+// 对string进行识别但不打码，返回各InfoType的数量、最高Level、ExtInfo分组以及是否有L4
+func (I *Engine) Classify(inputText string) (retResult *dlpheader.ClassifyResult, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	return classifyResults(I.detectNoMask(inputText)), nil
+}
+
+// ClassifyJSON detects jsonText without masking, returns aggregate result
+// 对json string进行识别但不打码，返回汇总结果
+func (I *Engine) ClassifyJSON(jsonText string) (retResult *dlpheader.ClassifyResult, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	kvList, _, err := I.jsonKVList(jsonText)
+	if err != nil {
+		return nil, err
+	}
+	return classifyResults(I.detectListNoMask(kvList)), nil
+}
+
+// ContainsSensitive checks whether inputText has a verified hit whose Level is not lower than minLevel, such as L3
+// it stops at the first hit and creates no result, detectors with dict run before detectors with regex
+// empty minLevel means all levels, other level than L1 ~ L4 returns ERR_LEVEL_INVALID
+// 判断文本是否包含不低于minLevel的敏感信息，命中即返回，不生成结果也不打码，适合准入检查
+func (I *Engine) ContainsSensitive(inputText string, minLevel string) (retHit bool, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return false, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	if len(inputText) > DEF_MAX_INPUT {
+		return false, fmt.Errorf("DEF_MAX_INPUT: %d , %w", DEF_MAX_INPUT, errlist.ERR_MAX_INPUT_LIMIT)
+	}
+	level := levelValue(minLevel)
+	if len(minLevel) != 0 && (level < 1 || level > 4) {
+		return false, fmt.Errorf("minLevel: %s, %w", minLevel, errlist.ERR_LEVEL_INVALID)
+	}
+	return I.containsSensitiveImpl(inputText, level), nil
+}
+
+// private func
+
+// containsSensitiveImpl runs detectors line by line like detectImpl, multi-line rules and decoders run at last
+func (I *Engine) containsSensitiveImpl(inputText string, minLevel int) bool {
+	valueList := make([]detector.DetectorAPI, 0, len(I.detectorOrder))
+	kvList := make([]detector.DetectorAPI, 0, len(I.detectorOrder))
+	multiList := make([]detector.DetectorAPI, 0)
+	for _, id := range I.detectorOrder {
+		obj := I.detectorMap[id]
+		if obj == nil || levelValue(obj.GetLevel()) < minLevel {
+			continue
+		}
+		if I.isOnlyForLog() && obj.GetRuleID() > DEF_MAX_REGEX_RULE_ID && obj.UseRegex() {
+			continue // same as detectBytes in log processor mod
+		}
+		switch {
+		case obj.IsKV():
+			kvList = append(kvList, obj)
+		case obj.IsMultiLine():
+			multiList = append(multiList, obj)
+		default:
+			valueList = append(valueList, obj)
+		}
+	}
+	if len(valueList)+len(kvList)+len(multiList) == 0 {
+		return false
+	}
+	rd := bufio.NewReaderSize(strings.NewReader(inputText), DEF_LineBlockSize)
+	for {
+		line, err := rd.ReadBytes('\n')
+		if len(line) > 0 {
+			line = I.detectPre(line)
+			for _, obj := range valueList {
+				if obj.HasHit(line) {
+					return true
+				}
+			}
+			if len(kvList) != 0 {
+				items := I.extractKVList(line)
+				for _, obj := range kvList {
+					if obj.HasHitList(items) {
+						return true
+					}
+				}
+			}
+			if len(I.decoderList) > 0 {
+				for _, res := range I.detectDecode(line, I.confObj.Global.MaxDecodeDepth, nil) {
+					if levelValue(res.Level) >= minLevel {
+						return true
+					}
+				}
+			}
+		}
+		if err != nil {
+			break
+		}
+	}
+	if len(multiList) != 0 {
+		input := I.detectPre([]byte(inputText))
+		for _, obj := range multiList {
+			if obj.HasHit(input) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sortDetector orders RuleID of detectors by cost, then RuleID
+func (I *Engine) sortDetector() {
+	I.detectorOrder = make([]int32, 0, len(I.detectorMap))
+	for id, obj := range I.detectorMap {
+		if obj != nil {
+			I.detectorOrder = append(I.detectorOrder, id)
+		}
+	}
+	sort.Slice(I.detectorOrder, func(i, j int) bool {
+		a, b := I.detectorMap[I.detectorOrder[i]], I.detectorMap[I.detectorOrder[j]]
+		if a.GetCost() != b.GetCost() {
+			return a.GetCost() < b.GetCost()
+		}
+		return a.GetRuleID() < b.GetRuleID()
+	})
+}
+
+// classifyResults aggregates results in one pass
+func classifyResults(results []*dlpheader.DetectResult) *dlpheader.ClassifyResult {
+	ret := &dlpheader.ClassifyResult{
+		Total:         len(results),
+		InfoTypeCount: make(map[string]int),
+		Groups:        make(map[string][]string),
+	}
+	seen := make(map[string]bool) // ExtInfo key and value
+	for _, res := range results {
+		ret.InfoTypeCount[res.InfoType]++
+		level := levelValue(res.Level)
+		if level > levelValue(ret.MaxLevel) {
+			ret.MaxLevel = res.Level
+		}
+		if level == 4 {
+			ret.HasL4 = true
+		}
+		for k, v := range res.ExtInfo {
+			if !seen[k+"\x00"+v] {
+				seen[k+"\x00"+v] = true
+				ret.Groups[k] = append(ret.Groups[k], v)
+			}
+		}
+	}
+	for _, values := range ret.Groups {
+		sort.Strings(values)
+	}
+	return ret
+}
+
+// classifyColumnsImpl classifies columns one by one
+func (I *Engine) classifyColumnsImpl(rows [][]string, header []string, sampleSize int) ([]*dlpheader.ColumnClass, error) {
+	colSize := len(header)
+	for _, row := range rows {
+		if len(row) > colSize {
+			colSize = len(row)
+		}
+	}
+	sampled := sampleRows(rows, sampleSize)
+	classes := make([]*dlpheader.ColumnClass, 0, colSize)
+	for col := 0; col < colSize; col++ {
+		class := &dlpheader.ColumnClass{Column: col}
+		key := ""
+		if col < len(header) {
+			class.Name = header[col]
+			key = strings.ToLower(strings.TrimSpace(header[col]))
+		}
+		class.HeaderHit = I.matchKeyRules(key)
+		countMap := make(map[string]int)    // InfoType => count of hit cells
+		levelMap := make(map[string]string) // InfoType => highest Level
+		for _, row := range sampled {
+			if col >= len(row) || len(row[col]) == 0 {
+				continue
+			}
+			class.Sampled++
+			var results []*dlpheader.DetectResult
+			var err error
+			if len(key) != 0 {
+				results, err = I.detectMapImpl(map[string]string{key: row[col]})
+			} else {
+				results, err = I.detectImpl(row[col])
+			}
+			if err != nil {
+				return nil, err
+			}
+			seen := make(map[string]bool)
+			for _, res := range results {
+				if levelValue(res.Level) > levelValue(class.Level) {
+					class.Level = res.Level
+				}
+				if levelValue(res.Level) > levelValue(levelMap[res.InfoType]) {
+					levelMap[res.InfoType] = res.Level
+				}
+				if !seen[res.InfoType] { // cell is counted once for each InfoType
+					seen[res.InfoType] = true
+					countMap[res.InfoType]++
+				}
+			}
+		}
+		// dominant InfoType has most hit cells, ties are broken by Level, then name
+		for infoType, cnt := range countMap {
+			best := countMap[class.InfoType]
+			if len(class.InfoType) == 0 || cnt > best ||
+				(cnt == best && levelValue(levelMap[infoType]) > levelValue(levelMap[class.InfoType])) ||
+				(cnt == best && levelMap[infoType] == levelMap[class.InfoType] && infoType < class.InfoType) {
+				class.InfoType = infoType
+			}
+		}
+		if class.Sampled > 0 && len(class.InfoType) != 0 {
+			class.HitRatio = float64(countMap[class.InfoType]) / float64(class.Sampled)
+		}
+		classes = append(classes, class)
+	}
+	return classes, nil
+}
+
+// matchKeyRules checks whether key alone matches key rules of any KV rule
+func (I *Engine) matchKeyRules(key string) bool {
+	if len(key) == 0 {
+		return false
+	}
+	for _, obj := range I.detectorMap {
+		if obj != nil && obj.MatchKey(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// sampleRows returns at most sampleSize rows, which are evenly spaced in rows
+func sampleRows(rows [][]string, sampleSize int) [][]string {
+	if sampleSize <= 0 || len(rows) <= sampleSize {
+		return rows
+	}
+	ret := make([][]string, 0, sampleSize)
+	for i := 0; i < sampleSize; i++ {
+		ret = append(ret, rows[i*len(rows)/sampleSize])
+	}
+	return ret
+}
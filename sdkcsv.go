@@ -0,0 +1,236 @@
+// Package dlp sdkcsv.go implements DeidentifyCSV, which masks CSV rows in streaming mode
+package dlp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bytedance/godlp/detector"
+	"github.com/bytedance/godlp/dlpheader"
+	"github.com/bytedance/godlp/errlist"
+)
+
+// csvCell is a cell of CSV record, raw is the bytes in input
+type csvCell struct {
+	raw    string
+	value  string
+	quoted bool
+}
+
+// csvReader reads CSV records, line ending of each record is kept
+type csvReader struct {
+	reader *bufio.Reader
+	comma  byte
+	cell   strings.Builder
+	raw    strings.Builder
+}
+
+// public func
+
+// DeidentifyCSV reads CSV rows from r, header row provides keys, then writes masked rows into w
+// each cell is detected like DetectMap, cells which are not masked are copied as they are
+// 流式对CSV打码，首行为表头，作为KV规则的key，结果中Line为行号，Column为列号
+func (I *Engine) DeidentifyCSV(r io.Reader, w io.Writer, opts *dlpheader.CSVOptions) (retResults []*dlpheader.DetectResult, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	if opts == nil {
+		opts = &dlpheader.CSVOptions{}
+	}
+	comma := opts.Comma
+	if comma == 0 {
+		comma = ','
+	}
+	if comma >= 0x80 || comma == '"' || comma == '\r' || comma == '\n' {
+		return nil, errlist.ERR_CSV_INVALID_COMMA
+	}
+	maskMap := make(map[string]string, len(opts.ColumnMask))
+	for col, maskName := range opts.ColumnMask {
+		if _, ok := I.maskerMap[maskName]; !ok {
+			return nil, fmt.Errorf("methodName: %s, error: %w", maskName, errlist.ERR_MASKWORKER_NOTFOUND)
+		}
+		maskMap[strings.ToLower(col)] = maskName
+	}
+	return I.deidentifyCSVImpl(r, w, byte(comma), maskMap)
+}
+
+// private func
+
+// deidentifyCSVImpl masks records one by one, memory is bounded by the longest record
+func (I *Engine) deidentifyCSVImpl(r io.Reader, w io.Writer, comma byte, maskMap map[string]string) ([]*dlpheader.DetectResult, error) {
+	rd := &csvReader{reader: bufio.NewReaderSize(r, DEF_LineBlockSize), comma: comma}
+	wr := bufio.NewWriterSize(w, DEF_LineBlockSize)
+	defer wr.Flush()
+	results := make([]*dlpheader.DetectResult, 0, DEF_RESULT_SIZE)
+	header := make([]string, 0)
+	for row := 1; ; row++ {
+		cells, eol, err := rd.readRecord()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return results, fmt.Errorf("%w, row: %d", err, row)
+		}
+		for col, cell := range cells {
+			if col > 0 {
+				if err := wr.WriteByte(comma); err != nil {
+					return results, err
+				}
+			}
+			out := cell.raw
+			if row == 1 { // header row
+				header = append(header, strings.ToLower(strings.TrimSpace(cell.value)))
+			} else if len(cell.value) != 0 {
+				key := strconv.Itoa(col + 1) // cell out of header uses column number as key
+				if col < len(header) && len(header[col]) != 0 {
+					key = header[col]
+				}
+				cellResults, masked, err := I.deidentifyCSVCell(key, cell.value, maskMap[key])
+				if err != nil {
+					return results, err
+				}
+				for _, res := range cellResults {
+					res.Line = row
+					res.Column = col + 1
+				}
+				results = append(results, cellResults...)
+				if masked != cell.value {
+					out = quoteCSVCell(masked, comma, cell.quoted)
+				}
+			}
+			if _, err := wr.WriteString(out); err != nil {
+				return results, err
+			}
+		}
+		if _, err := wr.WriteString(eol); err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+// deidentifyCSVCell detects and masks one cell, pinned cell is masked as a whole by maskName
+func (I *Engine) deidentifyCSVCell(key string, value string, maskName string) ([]*dlpheader.DetectResult, string, error) {
+	if len(maskName) != 0 {
+		masked, err := I.maskerMap[maskName].Mask(value)
+		if err != nil {
+			return nil, value, err
+		}
+		res := &dlpheader.DetectResult{
+			Text:       value,
+			MaskText:   masked,
+			ResultType: detector.RESULT_TYPE_KV,
+			Key:        key,
+			ByteStart:  0,
+			ByteEnd:    len(value),
+		}
+		return []*dlpheader.DetectResult{res}, masked, nil
+	}
+	results, err := I.detectListImpl([]*detector.KVItem{{Key: key, Value: value}})
+	if err != nil || len(results) == 0 {
+		return results, value, err
+	}
+	masked, err := I.deidentifyByResult(value, I.resultsForDeidentify(results))
+	return results, masked, err
+}
+
+// quoteCSVCell returns cell text, value is quoted if it was quoted or it needs quoting
+func quoteCSVCell(value string, comma byte, quoted bool) string {
+	if !quoted && strings.IndexByte(value, comma) == -1 && strings.IndexAny(value, "\"\r\n") == -1 {
+		return value
+	}
+	return "\"" + strings.Replace(value, "\"", "\"\"", -1) + "\""
+}
+
+// readRecord reads one record, eol is line ending of the record, empty at the end of input
+// quote in unquoted cell and text after closing quote are kept, like LazyQuotes of encoding/csv
+func (I *csvReader) readRecord() ([]*csvCell, string, error) {
+	cells := make([]*csvCell, 0, DEF_RESULT_SIZE)
+	started := false
+	for {
+		cell, ed, err := I.readCell()
+		if err != nil && err != io.EOF {
+			return nil, "", err
+		}
+		if err == io.EOF && !started && ed == 0 && len(cell.raw) == 0 {
+			return nil, "", io.EOF
+		}
+		started = true
+		cells = append(cells, cell)
+		switch ed {
+		case I.comma:
+			continue
+		case '\r':
+			return cells, "\r\n", nil
+		case '\n':
+			return cells, "\n", nil
+		default: // end of input
+			return cells, "", nil
+		}
+	}
+}
+
+// readCell reads one cell, ed is the byte after the cell, which is comma, \r for \r\n, \n, or 0 at the end of input
+func (I *csvReader) readCell() (*csvCell, byte, error) {
+	I.cell.Reset()
+	I.raw.Reset()
+	cell := new(csvCell)
+	ch, err := I.reader.ReadByte()
+	if err != nil {
+		return cell, 0, err
+	}
+	inQuote := false
+	if ch == '"' {
+		cell.quoted = true
+		inQuote = true
+		I.raw.WriteByte(ch)
+	} else if err := I.reader.UnreadByte(); err != nil {
+		return cell, 0, err
+	}
+	for {
+		ch, err := I.reader.ReadByte()
+		if err != nil {
+			cell.raw, cell.value = I.raw.String(), I.cell.String()
+			if err == io.EOF && inQuote {
+				return cell, 0, fmt.Errorf("%w, quote is not closed", io.ErrUnexpectedEOF)
+			}
+			return cell, 0, err
+		}
+		if inQuote {
+			I.raw.WriteByte(ch)
+			if ch != '"' {
+				I.cell.WriteByte(ch)
+				continue
+			}
+			if next, err := I.reader.Peek(1); err == nil && next[0] == '"' { // escaped quote
+				I.reader.ReadByte()
+				I.raw.WriteByte('"')
+				I.cell.WriteByte('"')
+				continue
+			}
+			inQuote = false
+			continue
+		}
+		if ch == I.comma || ch == '\n' {
+			cell.raw, cell.value = I.raw.String(), I.cell.String()
+			return cell, ch, nil
+		}
+		if ch == '\r' {
+			if next, err := I.reader.Peek(1); err == nil && next[0] == '\n' {
+				I.reader.ReadByte()
+				cell.raw, cell.value = I.raw.String(), I.cell.String()
+				return cell, '\r', nil
+			}
+		}
+		I.raw.WriteByte(ch)
+		I.cell.WriteByte(ch)
+	}
+}
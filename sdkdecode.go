@@ -0,0 +1,155 @@
+// Package dlp sdkdecode.go implements decode-and-scan for encoded segments
+package dlp
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bytedance/godlp/conf"
+	"github.com/bytedance/godlp/decoder"
+	"github.com/bytedance/godlp/detector"
+	"github.com/bytedance/godlp/dlpheader"
+	"github.com/bytedance/godlp/errlist"
+	"github.com/bytedance/godlp/mask"
+)
+
+// public func
+
+// RegisterDecoder registers DIY Decoder, segReg is regex for finding encoded segments, decodeFunc decodes a segment
+// 注册自定义解码函数，segReg 用于查找编码片段，Global.MaxDecodeDepth 需大于0
+func (I *Engine) RegisterDecoder(decoderName string, segReg string, decodeFunc func([]byte) ([]byte, error)) error {
+	defer I.recoveryImpl()
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	if I.isBuiltinDecoder(decoderName) || I.findDecoder(decoderName) != -1 {
+		return errlist.ERR_DECODER_NAME_CONFLICT
+	}
+	if obj, err := decoder.NewDecoder(decoderName, segReg, decodeFunc); err == nil {
+		I.decoderList = append(I.decoderList, obj)
+		I.changeRuleset()
+		return nil
+	} else {
+		return err
+	}
+}
+
+// private func
+
+// loadDecoder loads built-in decoders from config, DIY decoders which have been registered are kept
+func (I *Engine) loadDecoder() error {
+	list := make([]decoder.DecoderAPI, 0, len(I.decoderList)+len(decoder.BuiltinNames()))
+	if I.confObj.Global.MaxDecodeDepth > 0 {
+		names := I.confObj.Global.Decoders
+		if len(names) == 0 { // empty means all built-in decoders
+			names = decoder.BuiltinNames()
+		}
+		for _, name := range names {
+			if obj, err := decoder.NewBuiltinDecoder(name); err == nil {
+				list = append(list, obj)
+			} else {
+				return err
+			}
+		}
+	}
+	for _, obj := range I.decoderList {
+		if !I.isBuiltinDecoder(obj.GetName()) {
+			list = append(list, obj)
+		}
+	}
+	I.decoderList = list
+	return nil
+}
+
+// isBuiltinDecoder checks whether name is a built-in decoder name
+func (I *Engine) isBuiltinDecoder(name string) bool {
+	for _, item := range decoder.BuiltinNames() {
+		if strings.Compare(item, name) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// findDecoder returns index of decoder in decoderList, -1 if not found
+func (I *Engine) findDecoder(name string) int {
+	for i, obj := range I.decoderList {
+		if strings.Compare(obj.GetName(), name) == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// detectDecode finds encoded segments in line, then detects the decoded text recursively until depth is 0
+// the result covers the whole encoded segment, so that Deidentify will mask the whole segment
+func (I *Engine) detectDecode(line []byte, depth int32, path []string) []*dlpheader.DetectResult {
+	results := make([]*dlpheader.DetectResult, 0, DEF_RESULT_SIZE)
+	if depth <= 0 {
+		return results
+	}
+	for _, obj := range I.decoderList {
+		for _, pos := range obj.FindSegments(line) {
+			segment := line[pos[0]:pos[1]]
+			decoded, err := obj.Decode(segment)
+			if err != nil {
+				continue
+			}
+			subPath := make([]string, 0, len(path)+1)
+			subPath = append(subPath, path...)
+			subPath = append(subPath, obj.GetName())
+			decoded = I.detectPre(decoded)
+			innerResults := I.detectProcess(decoded, detector.RESULT_MODE_COPY)
+			innerResults = I.mergeResults(innerResults, I.detectDecode(decoded, depth-1, subPath))
+			for _, res := range innerResults {
+				if len(res.EncodingPath) == 0 { // found in decoded text directly
+					res.EncodingPath = subPath
+					res.DecodedText = res.Text
+				}
+				res.Text = string(segment)
+				res.ResultType = detector.RESULT_TYPE_VALUE
+				res.Key = ""
+				res.ByteStart = pos[0]
+				res.ByteEnd = pos[1]
+				results = append(results, res)
+			}
+		}
+	}
+	return results
+}
+
+// maskDecodedResult masks the whole encoded segment with the rule's mask
+// CHAR mask covers every char of the segment, because the rest of a partly masked segment may still be decoded
+// '*' is used if the mask of rule is not found or fails
+func (I *Engine) maskDecodedResult(res *dlpheader.DetectResult) {
+	if detector, ok := I.detectorMap[res.RuleID]; ok {
+		maskRuleName := detector.GetMaskRuleName()
+		if rule, ok := I.findMaskRule(maskRuleName); ok && rule.MaskType == mask.MASKTYPE_CHAR {
+			ch := "*"
+			if len(rule.Value) > 0 {
+				ch = rule.Value[:1]
+			}
+			res.MaskText = strings.Repeat(ch, utf8.RuneCountInString(res.Text))
+			return
+		}
+		if maskWorker, ok := I.maskerMap[maskRuleName]; ok {
+			if err := maskWorker.MaskResult(res); err == nil {
+				return
+			}
+		}
+	}
+	res.MaskText = strings.Repeat("*", utf8.RuneCountInString(res.Text))
+}
+
+// findMaskRule returns mask rule of config by name
+func (I *Engine) findMaskRule(ruleName string) (conf.MaskRuleItem, bool) {
+	for _, rule := range I.confObj.MaskRules {
+		if strings.Compare(rule.RuleName, ruleName) == 0 {
+			return rule, true
+		}
+	}
+	return conf.MaskRuleItem{}, false
+}
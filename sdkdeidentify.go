@@ -2,7 +2,6 @@
 package dlp
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/bytedance/godlp/dlpheader"
@@ -26,7 +25,13 @@ func (I *Engine) Deidentify(inputText string) (outputText string, retResults []*
 	if len(inputText) > DEF_MAX_INPUT {
 		return inputText, nil, fmt.Errorf("DEF_MAX_INPUT: %d , %w", DEF_MAX_INPUT, errlist.ERR_MAX_INPUT_LIMIT)
 	}
+	if results, out, ok := I.cacheGet(CACHE_DEIDENTIFY, inputText); ok {
+		return out, results, nil
+	}
 	outputText, retResults, retErr = I.deidentifyImpl(inputText)
+	if retErr == nil {
+		I.cachePut(CACHE_DEIDENTIFY, inputText, outputText, retResults)
+	}
 	return
 }
 
@@ -59,18 +64,16 @@ func (I *Engine) DeidentifyJSON(jsonText string) (outStr string, retResults []*d
 	if I.hasClosed() {
 		return jsonText, nil, errlist.ERR_PROCESS_AFTER_CLOSE
 	}
+	if results, out, ok := I.cacheGet(CACHE_DEIDENTIFY_JSON, jsonText); ok {
+		return out, results, nil
+	}
 	outStr = jsonText
 	if results, kvMap, err := I.detectJSONImpl(jsonText); err == nil {
 		retResults = results
-		var jsonObj interface{}
-		if err := json.Unmarshal([]byte(jsonText), &jsonObj); err == nil {
-			//kvMap := I.resultsToMap(results)
-			outObj := I.dfsJSON("", &jsonObj, kvMap, true)
-			if outJSON, err := json.Marshal(outObj); err == nil {
-				outStr = string(outJSON)
-			} else {
-				retErr = err
-			}
+		// rewrite leaves at token level, so that key order, whitespace and number literals are kept
+		if outJSON, err := I.rewriteJSON([]byte(jsonText), "", "", kvMap); err == nil {
+			outStr = string(outJSON)
+			I.cachePut(CACHE_DEIDENTIFY_JSON, jsonText, outStr, retResults)
 		} else {
 			retErr = err
 		}
@@ -94,10 +97,10 @@ func (I *Engine) DeidentifyJSONByResult(jsonText string, detectResults []*dlphea
 	}
 	outStr = jsonText
 	var jsonObj interface{}
-	if err := json.Unmarshal([]byte(jsonText), &jsonObj); err == nil {
+	if err := I.unmarshalJSON([]byte(jsonText), &jsonObj); err == nil {
 		kvMap := I.resultsToMap(detectResults)
-		outObj := I.dfsJSON("", &jsonObj, kvMap, true)
-		if outJSON, err := json.Marshal(outObj); err == nil {
+		// rewrite leaves at token level like DeidentifyJSON, so that both APIs have the same format
+		if outJSON, err := I.rewriteJSON([]byte(jsonText), "", "", kvMap); err == nil {
 			outStr = string(outJSON)
 		} else {
 			retErr = err
@@ -115,7 +118,8 @@ func (I *Engine) deidentifyImpl(inputText string) (outputText string, retResults
 	outputText = inputText // default same text
 	if arr, err := I.detectImpl(inputText); err == nil {
 		retResults = arr
-		if out, err := I.deidentifyByResult(inputText, retResults); err == nil {
+		// overlapped results may be kept by OverlapPolicy, so they need to be resolved before masking
+		if out, err := I.deidentifyByResult(inputText, I.resultsForDeidentify(retResults)); err == nil {
 			outputText = out
 		} else {
 			retErr = err
@@ -134,13 +138,9 @@ func (I *Engine) deidentifyMapImpl(inputMap map[string]string) (outMap map[strin
 			return inputMap, results, nil
 		} else {
 			outMap = inputMap
-			for _, item := range results {
-				if orig, ok := outMap[item.Key]; ok {
-					if out, err := I.deidentifyByResult(orig, []*dlpheader.DetectResult{item}); err == nil {
-						outMap[item.Key] = out
-					}
-				}
-			}
+			I.deidentifyValues(outMap, results, func(res *dlpheader.DetectResult) string {
+				return res.Key
+			})
 			retResults = results
 		}
 	} else {
@@ -150,30 +150,51 @@ func (I *Engine) deidentifyMapImpl(inputMap map[string]string) (outMap map[strin
 	return
 }
 
+// deidentifyValues masks values of valueMap by results, results are grouped by the key of valueMap which keyOf returns
+// each value is masked once by its non-overlapping results, because positions of results refer to the original value
+func (I *Engine) deidentifyValues(valueMap map[string]string, results []*dlpheader.DetectResult, keyOf func(*dlpheader.DetectResult) string) {
+	groupMap := make(map[string][]*dlpheader.DetectResult)
+	for _, res := range results {
+		key := keyOf(res)
+		groupMap[key] = append(groupMap[key], res)
+	}
+	for key, group := range groupMap {
+		if orig, ok := valueMap[key]; ok {
+			if out, err := I.deidentifyByResult(orig, I.resultsForDeidentify(group)); err == nil {
+				valueMap[key] = out
+			}
+		}
+	}
+}
+
 // deidentifyByResult concatenate MaskText
 func (I *Engine) deidentifyByResult(in string, arr []*dlpheader.DetectResult) (string, error) {
-	out := make([]byte, 0, len(in)+8)
+	out := I.appendByResult(make([]byte, 0, len(in)+8), S2B(in), arr)
+	outStr := B2S(out)
+	return outStr, nil
+}
+
+// appendByResult appends in into dst, text covered by results in arr is replaced by MaskText
+func (I *Engine) appendByResult(dst []byte, in []byte, arr []*dlpheader.DetectResult) []byte {
 	pos := 0
-	inArr := S2B(in)
 	for _, res := range arr {
 		if pos < res.ByteStart {
-			out = append(out, []byte(inArr[pos:res.ByteStart])...)
+			dst = append(dst, in[pos:res.ByteStart]...)
 		}
-		out = append(out, []byte(res.MaskText)...)
+		dst = append(dst, res.MaskText...)
 		pos = res.ByteEnd
 	}
 	if pos < len(in) {
-		out = append(out, []byte(inArr[pos:])...)
+		dst = append(dst, in[pos:]...)
 	}
-	outStr := B2S(out)
-	return outStr, nil
+	return dst
 }
 
-// resultsToMap convert results array into Map[Key]=MaskText
+// resultsToMap convert results array into Map[JSONPointer]=MaskText
 func (I *Engine) resultsToMap(results []*dlpheader.DetectResult) map[string]string {
 	kvMap := make(map[string]string)
 	for _, item := range results {
-		kvMap[item.Key] = item.MaskText
+		kvMap[item.JSONPointer] = item.MaskText
 	}
 	return kvMap
 }
@@ -2,10 +2,9 @@
 package dlp
 
 import (
-	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
-	"io"
 	"sort"
 	"strings"
 	"unicode/utf8"
@@ -31,7 +30,13 @@ func (I *Engine) Detect(inputText string) (retResults []*dlpheader.DetectResult,
 	if len(inputText) > DEF_MAX_INPUT {
 		return nil, fmt.Errorf("DEF_MAX_INPUT: %d , %w", DEF_MAX_INPUT, errlist.ERR_MAX_INPUT_LIMIT)
 	}
+	if results, _, ok := I.cacheGet(CACHE_DETECT, inputText); ok {
+		return results, nil
+	}
 	retResults, retErr = I.detectImpl(inputText)
+	if retErr == nil {
+		I.cachePut(CACHE_DETECT, inputText, "", retResults)
+	}
 	return
 }
 
@@ -69,7 +74,13 @@ func (I *Engine) DetectJSON(jsonText string) (retResults []*dlpheader.DetectResu
 	if I.hasClosed() {
 		return nil, errlist.ERR_PROCESS_AFTER_CLOSE
 	}
+	if results, _, ok := I.cacheGet(CACHE_DETECT_JSON, jsonText); ok {
+		return results, nil
+	}
 	retResults, _, retErr = I.detectJSONImpl(jsonText)
+	if retErr == nil {
+		I.cachePut(CACHE_DETECT_JSON, jsonText, "", retResults)
+	}
 	return
 }
 
@@ -77,39 +88,128 @@ func (I *Engine) DetectJSON(jsonText string) (retResults []*dlpheader.DetectResu
 
 // detectImpl works for the Detect API
 func (I *Engine) detectImpl(inputText string) ([]*dlpheader.DetectResult, error) {
-	rd := bufio.NewReaderSize(strings.NewReader(inputText), DEF_LineBlockSize)
-	currPos := 0
-	results := make([]*dlpheader.DetectResult, 0, DEF_RESULT_SIZE)
-	for {
-		line, err := rd.ReadBytes('\n')
-		if len(line) > 0 {
-			line := I.detectPre(line)
-			lineResults := I.detectProcess(line)
-			postResutls := I.detectPost(lineResults, currPos)
-			results = append(results, postResutls...)
-			currPos += len(line)
+	results := I.detectNoMask(inputText)
+	// mask after all results are merged, because merged result may be changed by overlap policy
+	results = I.detectPost(results, 0)
+	I.fillPosition(inputText, results)
+	return results, nil
+}
+
+// detectNoMask returns merged results of inputText, MaskText and positions other than byte are not filled
+func (I *Engine) detectNoMask(inputText string) []*dlpheader.DetectResult {
+	return I.detectBufNoMask(inputText, []byte(inputText), detector.RESULT_MODE_COPY, make([]*dlpheader.DetectResult, 0, DEF_RESULT_SIZE))
+}
+
+// detectBufNoMask works like detectNoMask, buf is a copy of inputText which is preprocessed line by line in place
+// results are appended into results, value results are created by mode, see detector.RESULT_MODE_*
+func (I *Engine) detectBufNoMask(inputText string, buf []byte, mode int, results []*dlpheader.DetectResult) []*dlpheader.DetectResult {
+	for currPos := 0; currPos < len(buf); {
+		// line boundary is found before detectPre, which may write '\n' for escaped char
+		ed := bytes.IndexByte(buf[currPos:], '\n')
+		if ed == -1 {
+			ed = len(buf)
+		} else {
+			ed += currPos + 1
+		}
+		origLine := buf[currPos:ed]
+		line, offsets := I.detectPreWithOffsets(origLine)
+		lineResults := I.detectProcess(line, mode)
+		if len(I.decoderList) > 0 {
+			decodeResults := I.detectDecode(line, I.confObj.Global.MaxDecodeDepth, nil)
+			lineResults = I.mergeResults(lineResults, decodeResults)
+		}
+		// positions in normalized line are converted back, see Global.Preprocess
+		I.restoreResultPos(lineResults, origLine, offsets)
+		lineResults = I.ajustResultPos(lineResults, currPos)
+		results = append(results, lineResults...)
+		currPos = ed
+	}
+	if multiResults := I.detectMultiLine(inputText); len(multiResults) > 0 {
+		// results inside a multi-line result will be ignored
+		results = I.mergeResults(results, multiResults)
+	}
+	return results
+}
+
+// fillPosition fills rune, UTF-16 and line/column positions of results by scanning inputText once
+func (I *Engine) fillPosition(inputText string, results []*dlpheader.DetectResult) {
+	if len(results) == 0 {
+		return
+	}
+	// collect byte offsets which need to be converted
+	offsetList := make([]int, 0, len(results)*2)
+	for _, res := range results {
+		offsetList = append(offsetList, res.ByteStart, res.ByteEnd)
+	}
+	sort.Ints(offsetList)
+	type position struct {
+		runePos, utf16Pos, line, column int
+	}
+	posMap := make(map[int]position, len(offsetList))
+	runePos, utf16Pos, line, column := 0, 0, 1, 1
+	i := 0
+	for _, offset := range offsetList {
+		for i < offset && i < len(inputText) {
+			r, width := utf8.DecodeRuneInString(inputText[i:])
+			runePos++
+			utf16Pos++
+			if r >= 0x10000 { // surrogate pair in UTF-16
+				utf16Pos++
+			}
+			if r == '\n' {
+				line++
+				column = 1
+			} else {
+				column++
+			}
+			i += width
 		}
-		if err != nil {
-			if err != io.EOF {
-				//show err
+		posMap[offset] = position{runePos, utf16Pos, line, column}
+	}
+	for _, res := range results {
+		st := posMap[res.ByteStart]
+		ed := posMap[res.ByteEnd]
+		res.RuneStart, res.RuneEnd = st.runePos, ed.runePos
+		res.UTF16Start, res.UTF16End = st.utf16Pos, ed.utf16Pos
+		res.Line, res.Column = st.line, st.column
+	}
+}
+
+// detectMultiLine detects the whole input by MultiLine rules, offsets are computed same as detectImpl
+func (I *Engine) detectMultiLine(inputText string) []*dlpheader.DetectResult {
+	results := make([]*dlpheader.DetectResult, 0, DEF_RESULT_SIZE)
+	var input, origInput []byte
+	var offsets []int
+	for _, obj := range I.detectorMap {
+		if obj != nil && obj.IsMultiLine() {
+			if I.isOnlyForLog() { // used in log processor mod, need very efficient
+				if obj.GetRuleID() > DEF_MAX_REGEX_RULE_ID && obj.UseRegex() { // if ID>MAX and rule uses regex
+					continue // will not use this rule in log processor mod
+				}
+			}
+			if input == nil { // lazy copy, detectPre will modify input
+				origInput = []byte(inputText)
+				input, offsets = I.detectPreWithOffsets(origInput)
+			}
+			if res, err := obj.DetectBytes(input); err == nil {
+				results = append(results, res...)
 			}
-			break
 		}
 	}
-	return results, nil
+	I.restoreResultPos(results, origInput, offsets)
+	return results
 }
 
-// detectPre calls prepare func before detect
+// detectPre calls prepare func before detect, length of line may be changed by Global.Preprocess
 func (I *Engine) detectPre(line []byte) []byte {
-	line = I.unquoteEscapeChar(line)
-	line = I.replaceWideChar(line)
+	line, _ = I.detectPreWithOffsets(line)
 	return line
 }
 
-// detectProcess detects sensitive info for a line
-func (I *Engine) detectProcess(line []byte) []*dlpheader.DetectResult {
+// detectProcess detects sensitive info for a line, value results are created by mode, see detector.RESULT_MODE_*
+func (I *Engine) detectProcess(line []byte, mode int) []*dlpheader.DetectResult {
 	// detect from a byte array
-	bytesResults, _ := I.detectBytes(line)
+	bytesResults, _ := I.detectBytes(line, mode)
 	// detect from a kvList which is extracted from the byte array
 	// kvList is used for the two item with same key
 	kvList := I.extractKVList(line)
@@ -119,18 +219,18 @@ func (I *Engine) detectProcess(line []byte) []*dlpheader.DetectResult {
 }
 
 // detectBytes detects for a line
-func (I *Engine) detectBytes(line []byte) ([]*dlpheader.DetectResult, error) {
+func (I *Engine) detectBytes(line []byte, mode int) ([]*dlpheader.DetectResult, error) {
 	results := make([]*dlpheader.DetectResult, 0, DEF_RESULT_SIZE)
 	var retErr error
 	//start := time.Now()
 	for _, obj := range I.detectorMap {
-		if obj != nil && obj.IsValue() {
+		if obj != nil && obj.IsValue() && !obj.IsMultiLine() {
 			if I.isOnlyForLog() { // used in log processor mod, need very efficient
 				if obj.GetRuleID() > DEF_MAX_REGEX_RULE_ID && obj.UseRegex() { // if ID>MAX and rule uses regex
 					continue // will not use this rule in log processor mod
 				}
 			}
-			res, err := obj.DetectBytes(line)
+			res, err := obj.DetectBytesMode(line, mode)
 			if err != nil {
 				retErr = err
 			}
@@ -144,8 +244,10 @@ func (I *Engine) detectBytes(line []byte) ([]*dlpheader.DetectResult, error) {
 }
 
 // extractKVList extracts KV item into a returned list
+// items found by extractors come first, k:v items which overlap with them are ignored
 func (I *Engine) extractKVList(line []byte) []*detector.KVItem {
-	kvList := make([]*detector.KVItem, 0, DEF_RESULT_SIZE)
+	kvList := I.extractByExtractors(line)
+	extSize := len(kvList)
 
 	sz := len(line)
 	for i := 0; i < sz; {
@@ -177,12 +279,15 @@ func (I *Engine) extractKVList(line []byte) []*detector.KVItem {
 			_ = kPos
 			if len(left) != 0 && len(right) != 0 {
 				loLeft := strings.ToLower(left)
-				kvList = append(kvList, &detector.KVItem{
+				item := &detector.KVItem{
 					Key:   loLeft,
 					Value: right,
 					Start: vPos[0],
 					End:   vPos[1],
-				})
+				}
+				if !isOverlappedKV(kvList[:extSize], item) {
+					kvList = append(kvList, item)
+				}
 			}
 		}
 		i += width
@@ -309,12 +414,12 @@ func (a ResultList) Less(i, j int) bool {
 
 // Contain checks whether a[i] contains a[j]
 func (a ResultList) Contain(i, j int) bool {
-	return a[i].Key == a[j].Key && a[i].ByteStart <= a[j].ByteStart && a[j].ByteEnd <= a[i].ByteEnd
+	return mergeKey(a[i]) == mergeKey(a[j]) && a[i].ByteStart <= a[j].ByteStart && a[j].ByteEnd <= a[i].ByteEnd
 }
 
 // Equal checks whether positions are equal
 func (a ResultList) Equal(i, j int) bool {
-	return a[i].ByteStart == a[j].ByteStart && a[j].ByteEnd == a[i].ByteEnd && a[i].Key == a[j].Key
+	return a[i].ByteStart == a[j].ByteStart && a[j].ByteEnd == a[i].ByteEnd && mergeKey(a[i]) == mergeKey(a[j])
 }
 
 // merge and sort two detect results
@@ -336,30 +441,47 @@ func (I *Engine) mergeResults(a []*dlpheader.DetectResult, b []*dlpheader.Detect
 	}
 	// sort
 	sort.Sort(ResultList(total))
+	switch policy := I.getOverlapPolicy(); policy {
+	case OVERLAP_POLICY_KEEP_ALL:
+		return total
+	case OVERLAP_POLICY_UNION:
+		return I.resolveByUnion(total, true)
+	case OVERLAP_POLICY_LEVEL, OVERLAP_POLICY_SCORE, OVERLAP_POLICY_LONGEST:
+		return I.resolveByPriority(total, policy)
+	}
 	sz := len(total)
 	mark := make([]bool, sz)
-	// firstly, all elements will be left
+	// sweep over intervals of each mergeKey, ordered by ByteStart asc, ByteEnd desc, RuleID desc,
+	// so that an interval is contained by another one iff a previous interval ends after it
+	order := make([]int, sz)
 	for i := 0; i < sz; i++ {
-		mark[i] = true
+		order[i] = i
 	}
-	for i := 0; i < sz; i++ {
-		if mark[i] {
-			for j := i + 1; j < sz; j++ {
-				if mark[j] {
-					// inner element will be ignored
-					if ResultList(total).Equal(i, j) {
-						mark[i] = false
-						break
-					} else {
-						if ResultList(total).Contain(i, j) {
-							mark[j] = false
-						}
-						if ResultList(total).Contain(j, i) {
-							mark[i] = false
-						}
-					}
-				}
-			}
+	sort.Slice(order, func(x, y int) bool {
+		a, b := total[order[x]], total[order[y]]
+		if keyA, keyB := mergeKey(a), mergeKey(b); keyA != keyB {
+			return keyA < keyB
+		}
+		if a.ByteStart != b.ByteStart {
+			return a.ByteStart < b.ByteStart
+		}
+		if a.ByteEnd != b.ByteEnd {
+			return a.ByteEnd > b.ByteEnd
+		}
+		return a.RuleID > b.RuleID
+	})
+	maxEnd := -1
+	for k, idx := range order {
+		if k == 0 || mergeKey(total[order[k-1]]) != mergeKey(total[idx]) { // new Key
+			maxEnd = -1
+		} else if ResultList(total).Equal(order[k-1], idx) {
+			// same position, the one with greater RuleID is left
+			continue
+		}
+		if total[idx].ByteEnd > maxEnd {
+			// inner element will be ignored
+			mark[idx] = true
+			maxEnd = total[idx].ByteEnd
 		}
 	}
 	ret := make([]*dlpheader.DetectResult, 0, sz)
@@ -385,6 +507,10 @@ func (I *Engine) ajustResultPos(results []*dlpheader.DetectResult, currPos int)
 // maskResults fill result.MaskText by calling mask.MaskResult()
 func (I *Engine) maskResults(results []*dlpheader.DetectResult) []*dlpheader.DetectResult {
 	for _, res := range results {
+		if len(res.EncodingPath) > 0 { // decoded result
+			I.maskDecodedResult(res)
+			continue
+		}
 		if detector, ok := I.detectorMap[res.RuleID]; ok {
 			maskRuleName := detector.GetMaskRuleName()
 			if maskWorker, ok := I.maskerMap[maskRuleName]; ok {
@@ -417,6 +543,31 @@ func (I *Engine) detectMapImpl(inputMap map[string]string) ([]*dlpheader.DetectR
 	return results, nil
 }
 
+// detectListImpl detects KV list from JSON object, KV items keep JSON Pointer
+func (I *Engine) detectListImpl(kvList []*detector.KVItem) ([]*dlpheader.DetectResult, error) {
+	results := I.detectListNoMask(kvList)
+	results = I.maskResults(results)
+
+	return results, nil
+}
+
+// detectListNoMask returns merged results of KV list, MaskText is not filled
+func (I *Engine) detectListNoMask(kvList []*detector.KVItem) []*dlpheader.DetectResult {
+	results := make([]*dlpheader.DetectResult, 0, DEF_RESULT_SIZE)
+	for _, obj := range I.detectorMap {
+		if obj != nil {
+			res, err := obj.DetectList(kvList)
+			if err != nil {
+				//log.Errorf(err.Error())
+			}
+			results = append(results, res...)
+		}
+	}
+	// merge result to reduce combined item
+	results = I.mergeResults(results, nil)
+	return results
+}
+
 func min(x, y int) int {
 	if x < y {
 		return x
@@ -432,20 +583,34 @@ func max(x, y int) int {
 
 // detectJSONImpl implements detectJSON
 func (I *Engine) detectJSONImpl(jsonText string) (retResults []*dlpheader.DetectResult, kvMap map[string]string, retErr error) {
+	kvList, kvMap, err := I.jsonKVList(jsonText)
+	if err != nil {
+		return nil, nil, err
+	}
+	retResults, retErr = I.detectListImpl(kvList)
+	I.deidentifyValues(kvMap, retResults, func(res *dlpheader.DetectResult) string {
+		return res.JSONPointer
+	})
+	return
+}
+
+// jsonKVList returns leaves of JSON as KV list, kvMap stores JSON Pointer and value of leaves
+func (I *Engine) jsonKVList(jsonText string) ([]*detector.KVItem, map[string]string, error) {
 	var jsonObj interface{}
-	if err := json.Unmarshal([]byte(jsonText), &jsonObj); err == nil {
+	if err := I.unmarshalJSON([]byte(jsonText), &jsonObj); err == nil {
 		//fmt.Printf("%+v\n", jsonObj)
-		kvMap = make(map[string]string, 0)
-		I.dfsJSON("", &jsonObj, kvMap, false)
-		retResults, retErr = I.detectMapImpl(kvMap)
-		for _, item := range retResults {
-			if orig, ok := kvMap[item.Key]; ok {
-				if out, err := I.deidentifyByResult(orig, []*dlpheader.DetectResult{item}); err == nil {
-					kvMap[item.Key] = out
-				}
-			}
+		kvMap := make(map[string]string, 0)
+		pathMap := make(map[string]string, 0)
+		I.dfsJSON("", "", &jsonObj, kvMap, pathMap, false)
+		kvList := make([]*detector.KVItem, 0, len(kvMap))
+		for pointer, val := range kvMap {
+			kvList = append(kvList, &detector.KVItem{
+				Key:     pathMap[pointer],
+				Value:   val,
+				Pointer: pointer,
+			})
 		}
-		return
+		return kvList, kvMap, nil
 	} else {
 		if e, ok := err.(*json.SyntaxError); ok {
 			return nil, nil, fmt.Errorf("%s: offset[%d], str[%s]", err.Error(), e.Offset,
@@ -0,0 +1,335 @@
+// Package dlp sdkencoding.go implements detect and deidentify APIs for input in GBK, GB18030 and UTF-16
+package dlp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/simplifiedchinese"
+
+	"github.com/bytedance/godlp/dlpheader"
+	"github.com/bytedance/godlp/errlist"
+)
+
+// supported encodings of input, AUTO means detecting by BOM and content
+const (
+	ENCODING_AUTO     = "AUTO"
+	ENCODING_UTF8     = "UTF-8"
+	ENCODING_GBK      = "GBK"
+	ENCODING_GB18030  = "GB18030"
+	ENCODING_UTF16    = "UTF-16" // byte order is detected by BOM and content
+	ENCODING_UTF16_LE = "UTF-16LE"
+	ENCODING_UTF16_BE = "UTF-16BE"
+)
+
+// transcoded holds UTF-8 text of input, offsets[i] is the offset in input of the char which has text[i]
+// len(offsets) is len(text)+1, the last one is len(input)
+type transcoded struct {
+	encoding string
+	text     []byte
+	offsets  []int
+	hasBOM   bool // BOM of input is skipped in text
+}
+
+// public func
+
+// DetectEncoded detects input in encoding, input is transcoded into UTF-8 for detection
+// ByteStart and ByteEnd of results are offsets in input, Text and MaskText are UTF-8 strings
+// RuneStart, UTF16Start and Column count chars of input, BOM is counted as one char
+// encoding is one of [UTF-8, GBK, GB18030, UTF-16, UTF-16LE, UTF-16BE], empty or AUTO means detecting it, detected encoding is returned
+// 对GBK、GB18030、UTF-16等编码的输入进行识别，结果的ByteStart和ByteEnd为原编码中的偏移，Text为UTF-8
+func (I *Engine) DetectEncoded(input []byte, encodingName string) (retResults []*dlpheader.DetectResult, retEncoding string, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return nil, "", errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	if len(input) > DEF_MAX_INPUT {
+		return nil, "", fmt.Errorf("DEF_MAX_INPUT: %d , %w", DEF_MAX_INPUT, errlist.ERR_MAX_INPUT_LIMIT)
+	}
+	tc, err := transcode(input, encodingName)
+	if err != nil {
+		return nil, "", err
+	}
+	retResults, retErr = I.detectImpl(B2S(tc.text))
+	tc.ajustResults(retResults)
+	retEncoding = tc.encoding
+	return
+}
+
+// DeidentifyEncoded detects input in encoding like DetectEncoded, then returns masked input in the same encoding
+// text which is not masked is copied as it is, MaskText which can not be encoded in GBK is written as '?'
+// 对GBK、GB18030、UTF-16等编码的输入先识别再打码，输出保持原编码，未打码的字节原样保留
+func (I *Engine) DeidentifyEncoded(input []byte, encodingName string) (outBytes []byte, retResults []*dlpheader.DetectResult, retEncoding string, retErr error) {
+	defer I.recoveryImpl()
+
+	outBytes = input
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return input, nil, "", errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	if I.isOnlyForLog() {
+		return input, nil, "", errlist.ERR_ONLY_FOR_LOG
+	}
+	if len(input) > DEF_MAX_INPUT {
+		return input, nil, "", fmt.Errorf("DEF_MAX_INPUT: %d , %w", DEF_MAX_INPUT, errlist.ERR_MAX_INPUT_LIMIT)
+	}
+	tc, err := transcode(input, encodingName)
+	if err != nil {
+		return input, nil, "", err
+	}
+	retEncoding = tc.encoding
+	if retResults, retErr = I.detectImpl(B2S(tc.text)); retErr != nil {
+		return
+	}
+	tc.ajustResults(retResults)
+	outBytes, retErr = appendEncodedByResult(make([]byte, 0, len(input)+8), input, I.resultsForDeidentify(retResults), tc.encoding)
+	return
+}
+
+// private func
+
+// transcode converts input into UTF-8 with offset map
+func transcode(input []byte, encodingName string) (*transcoded, error) {
+	name := strings.ToUpper(strings.TrimSpace(encodingName))
+	switch name {
+	case "", ENCODING_AUTO:
+		name = detectEncoding(input)
+	case ENCODING_UTF16:
+		name = detectUTF16Order(input)
+	case "UTF8":
+		name = ENCODING_UTF8
+	}
+	tc := &transcoded{
+		encoding: name,
+		text:     make([]byte, 0, len(input)+len(input)/2),
+		offsets:  make([]int, 0, len(input)+len(input)/2+1),
+	}
+	switch name {
+	case ENCODING_UTF8:
+		i := 0
+		if bytes.HasPrefix(input, []byte{0xEF, 0xBB, 0xBF}) {
+			i, tc.hasBOM = 3, true
+		}
+		tc.text = append(tc.text, input[i:]...)
+		for ; i < len(input); i++ {
+			tc.offsets = append(tc.offsets, i)
+		}
+	case ENCODING_GBK, ENCODING_GB18030:
+		tc.transcodeGB(input)
+	case ENCODING_UTF16_LE:
+		tc.transcodeUTF16(input, binary.LittleEndian)
+	case ENCODING_UTF16_BE:
+		tc.transcodeUTF16(input, binary.BigEndian)
+	default:
+		return nil, fmt.Errorf("encoding: %s, %w", encodingName, errlist.ERR_ENCODING_NOT_SUPPORT)
+	}
+	tc.offsets = append(tc.offsets, len(input))
+	return tc, nil
+}
+
+// detectEncoding detects encoding by BOM, then by zero bytes of UTF-16, then by UTF-8 validation, GB18030 at last
+func detectEncoding(input []byte) string {
+	switch {
+	case bytes.HasPrefix(input, []byte{0xEF, 0xBB, 0xBF}):
+		return ENCODING_UTF8
+	case bytes.HasPrefix(input, []byte{0xFF, 0xFE}):
+		return ENCODING_UTF16_LE
+	case bytes.HasPrefix(input, []byte{0xFE, 0xFF}):
+		return ENCODING_UTF16_BE
+	}
+	if name := guessUTF16Order(input); len(name) != 0 {
+		return name
+	}
+	if utf8.Valid(input) {
+		return ENCODING_UTF8
+	}
+	return ENCODING_GB18030
+}
+
+// detectUTF16Order detects byte order of UTF-16 by BOM and zero bytes, little endian is used by default
+func detectUTF16Order(input []byte) string {
+	switch {
+	case bytes.HasPrefix(input, []byte{0xFF, 0xFE}):
+		return ENCODING_UTF16_LE
+	case bytes.HasPrefix(input, []byte{0xFE, 0xFF}):
+		return ENCODING_UTF16_BE
+	}
+	if name := guessUTF16Order(input); len(name) != 0 {
+		return name
+	}
+	return ENCODING_UTF16_LE
+}
+
+// guessUTF16Order checks zero bytes of ASCII chars in UTF-16, returns empty string if input does not look like UTF-16
+func guessUTF16Order(input []byte) string {
+	if len(input) < 2 || len(input)%2 != 0 {
+		return ""
+	}
+	zeroEven, zeroOdd := 0, 0
+	for i := 0; i+1 < len(input); i += 2 {
+		if input[i] == 0 {
+			zeroEven++
+		}
+		if input[i+1] == 0 {
+			zeroOdd++
+		}
+	}
+	units := len(input) / 2
+	switch {
+	case zeroOdd*4 >= units && zeroEven*4 < zeroOdd:
+		return ENCODING_UTF16_LE
+	case zeroEven*4 >= units && zeroOdd*4 < zeroEven:
+		return ENCODING_UTF16_BE
+	}
+	return ""
+}
+
+// transcodeGB decodes GBK and GB18030 char by char, invalid bytes are decoded as U+FFFD
+func (I *transcoded) transcodeGB(input []byte) {
+	dec := simplifiedchinese.GB18030.NewDecoder()
+	var buf [utf8.UTFMax * 4]byte
+	for i := 0; i < len(input); {
+		if input[i] < utf8.RuneSelf {
+			I.appendChar(input[i:i+1], i)
+			i++
+			continue
+		}
+		sz := gbCharSize(input, i)
+		dec.Reset()
+		nDst, _, err := dec.Transform(buf[:], input[i:i+sz], true)
+		if err != nil || nDst == 0 {
+			I.appendChar([]byte(string(utf8.RuneError)), i)
+		} else {
+			I.appendChar(buf[:nDst], i)
+		}
+		i += sz
+	}
+}
+
+// gbCharSize returns byte size of GB18030 char at input[i], 1 for invalid byte
+func gbCharSize(input []byte, i int) int {
+	c0 := input[i]
+	if c0 <= 0x80 || c0 == 0xFF || i+1 >= len(input) {
+		return 1
+	}
+	c1 := input[i+1]
+	switch {
+	case (0x40 <= c1 && c1 < 0x7F) || (0x80 <= c1 && c1 < 0xFF):
+		return 2
+	case 0x30 <= c1 && c1 < 0x3A && i+3 < len(input):
+		if c2, c3 := input[i+2], input[i+3]; 0x81 <= c2 && c2 < 0xFF && 0x30 <= c3 && c3 < 0x3A {
+			return 4
+		}
+	}
+	return 1
+}
+
+// transcodeUTF16 decodes UTF-16 code units, surrogate pair is decoded as one char, BOM is skipped
+func (I *transcoded) transcodeUTF16(input []byte, order binary.ByteOrder) {
+	i := 0
+	if len(input) >= 2 && order.Uint16(input) == 0xFEFF {
+		i, I.hasBOM = 2, true
+	}
+	var buf [utf8.UTFMax]byte
+	for i < len(input) {
+		if i+1 >= len(input) { // odd byte at the end
+			I.appendChar([]byte(string(utf8.RuneError)), i)
+			break
+		}
+		r, sz := rune(order.Uint16(input[i:])), 2
+		if utf16.IsSurrogate(r) && i+3 < len(input) {
+			if pair := utf16.DecodeRune(r, rune(order.Uint16(input[i+2:]))); pair != utf8.RuneError {
+				r, sz = pair, 4
+			}
+		}
+		n := utf8.EncodeRune(buf[:], r)
+		I.appendChar(buf[:n], i)
+		i += sz
+	}
+}
+
+// appendChar appends UTF-8 bytes of a char whose offset is pos in input
+func (I *transcoded) appendChar(utf8Bytes []byte, pos int) {
+	I.text = append(I.text, utf8Bytes...)
+	for range utf8Bytes {
+		I.offsets = append(I.offsets, pos)
+	}
+}
+
+// ajustResults converts ByteStart and ByteEnd of results from offsets in UTF-8 text into offsets in input
+// rune, UTF-16 and column positions are shifted by the skipped BOM, UTF-16 positions of UTF-16 input are code units of input
+func (I *transcoded) ajustResults(results []*dlpheader.DetectResult) {
+	shift := 0
+	if I.hasBOM {
+		shift = 1
+	}
+	for _, res := range results {
+		if res.ByteStart >= 0 && res.ByteEnd < len(I.offsets) && res.ByteStart <= res.ByteEnd {
+			res.ByteStart, res.ByteEnd = I.offsets[res.ByteStart], I.offsets[res.ByteEnd]
+		}
+		res.RuneStart += shift
+		res.RuneEnd += shift
+		res.UTF16Start += shift
+		res.UTF16End += shift
+		if res.Line == 1 {
+			res.Column += shift
+		}
+		switch I.encoding {
+		case ENCODING_UTF16_LE, ENCODING_UTF16_BE:
+			res.UTF16Start, res.UTF16End = res.ByteStart/2, res.ByteEnd/2
+		}
+	}
+}
+
+// appendEncodedByResult works like appendByResult, MaskText is encoded in encodingName
+func appendEncodedByResult(dst []byte, input []byte, arr []*dlpheader.DetectResult, encodingName string) ([]byte, error) {
+	var enc *encoding.Encoder
+	switch encodingName {
+	case ENCODING_GBK:
+		enc = encoding.ReplaceUnsupported(simplifiedchinese.GBK.NewEncoder())
+	case ENCODING_GB18030:
+		enc = simplifiedchinese.GB18030.NewEncoder()
+	}
+	pos := 0
+	for _, res := range arr {
+		if pos < res.ByteStart {
+			dst = append(dst, input[pos:res.ByteStart]...)
+		}
+		switch encodingName {
+		case ENCODING_GBK, ENCODING_GB18030:
+			maskBytes, err := enc.Bytes([]byte(res.MaskText))
+			if err != nil {
+				return dst, err
+			}
+			dst = append(dst, maskBytes...)
+		case ENCODING_UTF16_LE, ENCODING_UTF16_BE:
+			order := binary.ByteOrder(binary.LittleEndian)
+			if encodingName == ENCODING_UTF16_BE {
+				order = binary.BigEndian
+			}
+			var unit [2]byte
+			for _, u := range utf16.Encode([]rune(res.MaskText)) {
+				order.PutUint16(unit[:], u)
+				dst = append(dst, unit[:]...)
+			}
+		default:
+			dst = append(dst, res.MaskText...)
+		}
+		pos = res.ByteEnd
+	}
+	if pos < len(input) {
+		dst = append(dst, input[pos:]...)
+	}
+	return dst, nil
+}
@@ -0,0 +1,108 @@
+// Package dlp sdkextract.go implements KV extraction by extractors, such as query string, cookie and logfmt
+package dlp
+
+import (
+	"strings"
+
+	"github.com/bytedance/godlp/detector"
+	"github.com/bytedance/godlp/errlist"
+	"github.com/bytedance/godlp/extractor"
+)
+
+// public func
+
+// RegisterExtractor registers DIY KV Extractor, extractFunc returns position list of KV items in line,
+// each position is [keyStart, keyEnd, valueStart, valueEnd)
+// 注册自定义KV提取函数，提取出的KV会用于KV规则的识别
+func (I *Engine) RegisterExtractor(extractorName string, extractFunc func(line []byte) [][]int) error {
+	defer I.recoveryImpl()
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	if I.isBuiltinExtractor(extractorName) || I.findExtractor(extractorName) != -1 {
+		return errlist.ERR_EXTRACTOR_CONFLICT
+	}
+	if obj, err := extractor.NewExtractor(extractorName, extractFunc); err == nil {
+		I.extractorList = append(I.extractorList, obj)
+		I.changeRuleset()
+		return nil
+	} else {
+		return err
+	}
+}
+
+// private func
+
+// loadExtractor loads built-in extractors from config, DIY extractors which have been registered are kept
+func (I *Engine) loadExtractor() error {
+	list := make([]extractor.ExtractorAPI, 0, len(I.extractorList)+len(I.confObj.Global.Extractors))
+	// built-in extractors are loaded in specificity order, not config order
+	for _, name := range extractor.BuiltinNames() {
+		for _, item := range I.confObj.Global.Extractors {
+			if strings.Compare(item, name) != 0 {
+				continue
+			}
+			if obj, err := extractor.NewBuiltinExtractor(name); err == nil {
+				list = append(list, obj)
+			} else {
+				return err
+			}
+			break
+		}
+	}
+	for _, obj := range I.extractorList {
+		if !I.isBuiltinExtractor(obj.GetName()) {
+			list = append(list, obj)
+		}
+	}
+	I.extractorList = list
+	return nil
+}
+
+// isBuiltinExtractor checks whether name is a built-in extractor name
+func (I *Engine) isBuiltinExtractor(name string) bool {
+	for _, item := range extractor.BuiltinNames() {
+		if strings.Compare(item, name) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// findExtractor returns index of extractor in extractorList, -1 if not found
+func (I *Engine) findExtractor(name string) int {
+	for i, obj := range I.extractorList {
+		if strings.Compare(obj.GetName(), name) == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// extractByExtractors extracts KV items by all extractors,
+// items which overlap with items of previous extractors are ignored, so that built-in extractors are ordered by specificity
+func (I *Engine) extractByExtractors(line []byte) []*detector.KVItem {
+	kvList := make([]*detector.KVItem, 0, DEF_RESULT_SIZE)
+	for _, obj := range I.extractorList {
+		prevSize := len(kvList)
+		for _, item := range obj.Extract(line) {
+			if !isOverlappedKV(kvList[:prevSize], item) {
+				kvList = append(kvList, item)
+			}
+		}
+	}
+	return kvList
+}
+
+// isOverlappedKV checks whether value of item overlaps with value of any item in kvList
+func isOverlappedKV(kvList []*detector.KVItem, item *detector.KVItem) bool {
+	for _, obj := range kvList {
+		if obj.Start < item.End && item.Start < obj.End {
+			return true
+		}
+	}
+	return false
+}
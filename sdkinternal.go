@@ -2,12 +2,14 @@
 package dlp
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"github.com/bytedance/godlp/detector"
 	"github.com/bytedance/godlp/errlist"
 	"github.com/bytedance/godlp/log"
 	"github.com/bytedance/godlp/mask"
+	"io"
 	"os"
 	"runtime/debug"
 	"strings"
@@ -91,6 +93,14 @@ func (I *Engine) postLoadConfig() error {
 	if err := I.loadMaskWorker(); err != nil {
 		return err
 	}
+	if err := I.loadDecoder(); err != nil {
+		return err
+	}
+	if err := I.loadExtractor(); err != nil {
+		return err
+	}
+	I.loadPreprocess()
+	I.loadResultCache()
 	I.isConfiged = true
 	return nil
 }
@@ -116,6 +126,7 @@ func (I *Engine) initLogger() error {
 func (I *Engine) loadDetector() error {
 	// fill detectorMap
 	I.fillDetectorMap()
+	I.sortDetector()
 	// disable rules
 	return I.disableRulesImpl(I.confObj.Global.DisableRules)
 }
@@ -140,15 +151,18 @@ func (I *Engine) loadMaskWorker() error {
 }
 
 // dfsJSON walk a json object, used for DetectJSON and DeidentifyJSON
-// in DetectJSON(), isDeidentify is false, kvMap is write only, will store json object path and value
-// in DeidentifyJSON(), isDeidentify is true, kvMap is read only, will store path and MaskText of sensitive information
-func (I *Engine) dfsJSON(path string, ptr *interface{}, kvMap map[string]string, isDeidentify bool) interface{} {
+// kvMap is keyed by RFC 6901 JSON Pointer with original key casing, so that keys which differ in case or contain / are not mixed
+// in DetectJSON(), isDeidentify is false, kvMap is write only, will store pointer and value
+// in DeidentifyJSON(), isDeidentify is true, kvMap is read only, will store pointer and MaskText of sensitive information
+// if pathMap is not nil, it will store pointer and lower case path such as /a/b[0], which is the key for KV rules
+func (I *Engine) dfsJSON(path string, pointer string, ptr *interface{}, kvMap map[string]string, pathMap map[string]string, isDeidentify bool) interface{} {
 	path = strings.ToLower(path)
 	switch (*ptr).(type) {
 	case map[string]interface{}:
 		for k, v := range (*ptr).(map[string]interface{}) {
 			subpath := path + "/" + k
-			(*ptr).(map[string]interface{})[k] = I.dfsJSON(subpath, &v, kvMap, isDeidentify)
+			subPointer := pointer + "/" + detector.EscapeJSONPointer(k)
+			(*ptr).(map[string]interface{})[k] = I.dfsJSON(subpath, subPointer, &v, kvMap, pathMap, isDeidentify)
 		}
 	case []interface{}:
 		for i, v := range (*ptr).([]interface{}) {
@@ -158,15 +172,16 @@ func (I *Engine) dfsJSON(path string, ptr *interface{}, kvMap map[string]string,
 			} else {
 				subpath = fmt.Sprintf("%s[%d]", path, i)
 			}
-			(*ptr).([]interface{})[i] = I.dfsJSON(subpath, &v, kvMap, isDeidentify)
+			subPointer := fmt.Sprintf("%s/%d", pointer, i)
+			(*ptr).([]interface{})[i] = I.dfsJSON(subpath, subPointer, &v, kvMap, pathMap, isDeidentify)
 		}
 	case string:
 		var subObj interface{}
 		if val, ok := (*ptr).(string); ok {
 			// try nested json Unmarshal
 			if I.maybeJSON(val) {
-				if err := json.Unmarshal([]byte(val), &subObj); err == nil {
-					obj := I.dfsJSON(path, &subObj, kvMap, isDeidentify)
+				if err := I.unmarshalJSON([]byte(val), &subObj); err == nil {
+					obj := I.dfsJSON(path, pointer, &subObj, kvMap, pathMap, isDeidentify)
 					if ret, err := json.Marshal(obj); err == nil {
 						retStr := string(ret)
 						return retStr
@@ -176,21 +191,88 @@ func (I *Engine) dfsJSON(path string, ptr *interface{}, kvMap map[string]string,
 				}
 			} else { // plain text
 				if isDeidentify {
-					if mask, ok := kvMap[path]; ok {
+					if mask, ok := kvMap[pointer]; ok {
 						return mask
 					} else {
 						return val
 					}
 				} else {
-					kvMap[path] = val
+					kvMap[pointer] = val
+					if pathMap != nil {
+						pathMap[pointer] = path
+					}
 					return val
 				}
 			}
 		}
+	case json.Number, bool:
+		// numbers are scanned by original literal, bool is scanned as true or false
+		val := fmt.Sprint(*ptr)
+		if isDeidentify {
+			// kvMap contains all leaves, literal is not changed if it is not masked
+			if mask, ok := kvMap[pointer]; ok && mask != val {
+				return I.maskJSONLiteral(*ptr, mask)
+			}
+		} else {
+			kvMap[pointer] = val
+			if pathMap != nil {
+				pathMap[pointer] = path
+			}
+		}
 	}
 	return *ptr
 }
 
+// unmarshalJSON unmarshals JSON text like json.Unmarshal, but numbers are kept as json.Number to avoid precision loss
+func (I *Engine) unmarshalJSON(data []byte, v *interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return errlist.ERR_JSON_TRAILING_DATA
+	}
+	return nil
+}
+
+// maskJSONLiteral returns masked value of JSON number or bool by Global.JSONNumberMask
+// in STRING mode, mask is returned as string
+// in KEEP_TYPE mode, masked number is still a number, masked chars are replaced by Global.JSONMaskDigit, bool is still returned as string
+func (I *Engine) maskJSONLiteral(orig interface{}, mask string) interface{} {
+	num, ok := orig.(json.Number)
+	if !ok || !strings.EqualFold(I.confObj.Global.JSONNumberMask, JSON_NUMBER_MASK_KEEP_TYPE) {
+		return mask
+	}
+	if isJSONNumber(mask) {
+		return json.Number(mask)
+	}
+	literal := []rune(num.String())
+	maskRunes := []rune(mask)
+	if len(literal) != len(maskRunes) { // mask changes length, such as TAG
+		return mask
+	}
+	digit := rune(DEF_JSON_MASK_DIGIT)
+	if len(I.confObj.Global.JSONMaskDigit) != 0 {
+		digit = rune(I.confObj.Global.JSONMaskDigit[0])
+	}
+	for i, r := range maskRunes {
+		if r != literal[i] && literal[i] >= '0' && literal[i] <= '9' {
+			maskRunes[i] = digit
+		}
+	}
+	if out := string(maskRunes); isJSONNumber(out) {
+		return json.Number(out)
+	}
+	return mask
+}
+
+// isJSONNumber checks whether in is a valid JSON number literal
+func isJSONNumber(in string) bool {
+	var num json.Number
+	return json.Unmarshal([]byte(in), &num) == nil && len(in) != 0 && (in[0] == '-' || (in[0] >= '0' && in[0] <= '9'))
+}
+
 // maybeJSON check whether input string is a JSON object or array
 func (I *Engine) maybeJSON(in string) bool {
 	maybeObj := strings.IndexByte(in, '{') != -1 && strings.LastIndexByte(in, '}') != -1
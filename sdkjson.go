@@ -0,0 +1,442 @@
+// Package dlp sdkjson.go implements token level JSON walker, which rewrites leaves without changing other bytes
+// DetectJSONStream and DeidentifyJSONStream are built on it
+package dlp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/bytedance/godlp/detector"
+	"github.com/bytedance/godlp/dlpheader"
+	"github.com/bytedance/godlp/errlist"
+)
+
+// const var for JSON leaf kind
+const (
+	JSON_LEAF_STRING = 's'
+	JSON_LEAF_NUMBER = 'n'
+	JSON_LEAF_BOOL   = 'b'
+	JSON_LEAF_NULL   = 'z'
+)
+
+// state of jsonFrame, which is the next token expected in container
+const (
+	jsonStateStart = iota // after { or [, key, value or close is expected
+	jsonStateKey          // after , in object
+	jsonStateColon        // after key
+	jsonStateValue        // after : in object or , in array
+	jsonStateNext         // after value, , or close is expected
+)
+
+// jsonLeafFunc is called for each leaf, raw is the literal in input, returns the literal written to output
+type jsonLeafFunc func(path string, pointer string, kind byte, raw []byte) ([]byte, error)
+
+// jsonFrame is a container on the stack of jsonWalker
+type jsonFrame struct {
+	isArray bool
+	state   int    // one of jsonState
+	index   int    // index of current element in array
+	path    string // path of container, same as dfsJSON
+	pointer string // RFC 6901 JSON Pointer of container
+	key     string // current key in object, original casing
+}
+
+// jsonWalker walks JSON tokens from reader, copies bytes into writer and calls onLeaf for each leaf
+// memory is bounded by the longest token and depth of JSON
+type jsonWalker struct {
+	reader *bufio.Reader
+	writer *bufio.Writer
+	onLeaf jsonLeafFunc
+	stack  []*jsonFrame
+	token  []byte // buffer for current token
+}
+
+// jsonStream detects leaves of JSON stream one by one, results are collected with record index
+type jsonStream struct {
+	eng          *Engine
+	isDeidentify bool
+	record       int
+	results      []*dlpheader.DetectResult
+}
+
+// public func
+
+// DetectJSONStream detects JSON values read from r one by one, such as newline-delimited JSON
+// memory is bounded by the longest token, DetectResult.Record is the index of JSON value starting from 1
+// 流式识别JSON，支持每行一个JSON的NDJSON格式，结果中的Record为JSON记录序号，从1开始
+func (I *Engine) DetectJSONStream(r io.Reader) (retResults []*dlpheader.DetectResult, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	return I.streamJSONImpl(r, ioutil.Discard, false)
+}
+
+// DeidentifyJSONStream detects JSON values read from r one by one, then writes masked JSON into w
+// bytes other than masked values are copied as they are
+// 流式识别JSON并打码，打码后的JSON写入w，除打码的值之外原样输出
+func (I *Engine) DeidentifyJSONStream(r io.Reader, w io.Writer) (retResults []*dlpheader.DetectResult, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	return I.streamJSONImpl(r, w, true)
+}
+
+// private func
+
+// streamJSONImpl walks JSON values from r, each top level value is one record
+func (I *Engine) streamJSONImpl(r io.Reader, w io.Writer, isDeidentify bool) ([]*dlpheader.DetectResult, error) {
+	stream := &jsonStream{eng: I, isDeidentify: isDeidentify, results: make([]*dlpheader.DetectResult, 0, DEF_RESULT_SIZE)}
+	walker := newJSONWalker(r, w, stream.onLeaf)
+	for {
+		stream.record++
+		if err := walker.walkValue("", ""); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return stream.results, fmt.Errorf("%w, record: %d", err, stream.record)
+		}
+	}
+	return stream.results, nil
+}
+
+// onLeaf detects one leaf, returns masked literal in deidentify mode
+func (I *jsonStream) onLeaf(path string, pointer string, kind byte, raw []byte) ([]byte, error) {
+	val := ""
+	switch kind {
+	case JSON_LEAF_NULL:
+		return raw, nil
+	case JSON_LEAF_STRING:
+		if err := json.Unmarshal(raw, &val); err != nil {
+			return nil, err
+		}
+		if I.eng.maybeJSON(val) { // nested json in string, invalid one is skipped like dfsJSON
+			if !json.Valid([]byte(val)) {
+				return raw, nil
+			}
+			out := bytes.NewBuffer(make([]byte, 0, len(val)))
+			if err := newJSONWalker(strings.NewReader(val), out, I.onLeaf).walkValue(path, pointer); err != nil {
+				return nil, err
+			}
+			if !I.isDeidentify || out.String() == val {
+				return raw, nil
+			}
+			return encodeJSONString(out.String())
+		}
+	default:
+		val = string(raw)
+	}
+	results, err := I.eng.detectListImpl([]*detector.KVItem{{Key: path, Value: val, Pointer: pointer}})
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range results {
+		res.Record = I.record
+	}
+	I.results = append(I.results, results...)
+	if !I.isDeidentify || len(results) == 0 {
+		return raw, nil
+	}
+	mask, err := I.eng.deidentifyByResult(val, I.eng.resultsForDeidentify(results))
+	if err != nil {
+		return nil, err
+	}
+	return I.eng.rewriteJSONLeaf(path, pointer, kind, raw, map[string]string{pointer: mask})
+}
+
+// newJSONWalker creates jsonWalker object
+func newJSONWalker(r io.Reader, w io.Writer, onLeaf jsonLeafFunc) *jsonWalker {
+	obj := new(jsonWalker)
+	if br, ok := r.(*bufio.Reader); ok {
+		obj.reader = br
+	} else {
+		obj.reader = bufio.NewReaderSize(r, DEF_LineBlockSize)
+	}
+	obj.writer = bufio.NewWriterSize(w, DEF_LineBlockSize)
+	obj.onLeaf = onLeaf
+	obj.stack = make([]*jsonFrame, 0, DEF_RESULT_SIZE)
+	obj.token = make([]byte, 0, DEF_LineBlockSize)
+	return obj
+}
+
+// walkValue walks one top level JSON value, path and pointer are prefix of leaves
+// whitespace before the value is copied, returns io.EOF if there is no more value
+func (I *jsonWalker) walkValue(path string, pointer string) error {
+	defer I.writer.Flush()
+	I.stack = I.stack[:0]
+	started := false
+	for {
+		ch, err := I.reader.ReadByte()
+		if err != nil {
+			if err == io.EOF && started {
+				return io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		top := I.top()
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\r' || ch == '\n':
+			if err := I.write(ch); err != nil {
+				return err
+			}
+			continue
+		case ch == ',':
+			if top == nil || top.state != jsonStateNext {
+				return errlist.ERR_JSON_INVALID
+			}
+			top.index++
+			top.state = jsonStateValue
+			if !top.isArray {
+				top.state = jsonStateKey
+			}
+			if err := I.write(ch); err != nil {
+				return err
+			}
+			continue
+		case ch == ':':
+			if top == nil || top.state != jsonStateColon {
+				return errlist.ERR_JSON_INVALID
+			}
+			top.state = jsonStateValue
+			if err := I.write(ch); err != nil {
+				return err
+			}
+			continue
+		case ch == '}' || ch == ']':
+			if top == nil || top.isArray != (ch == ']') || (top.state != jsonStateStart && top.state != jsonStateNext) {
+				return errlist.ERR_JSON_INVALID
+			}
+			I.stack = I.stack[:len(I.stack)-1]
+			if err := I.write(ch); err != nil {
+				return err
+			}
+		case ch == '"' && top != nil && !top.isArray && (top.state == jsonStateStart || top.state == jsonStateKey): // key of object
+			raw, err := I.readString()
+			if err != nil {
+				return err
+			}
+			if err := json.Unmarshal(raw, &top.key); err != nil {
+				return err
+			}
+			top.state = jsonStateColon
+			if _, err := I.writer.Write(raw); err != nil {
+				return err
+			}
+			continue
+		default: // value
+			if top != nil && top.state != jsonStateValue && !(top.isArray && top.state == jsonStateStart) {
+				return errlist.ERR_JSON_INVALID
+			}
+			if err := I.walkToken(ch, path, pointer); err != nil {
+				return err
+			}
+			if ch == '{' || ch == '[' {
+				started = true
+				continue
+			}
+		}
+		started = true
+		if len(I.stack) == 0 { // top level value is finished
+			return nil
+		}
+		I.top().state = jsonStateNext
+	}
+}
+
+// walkToken handles the first token of a value, container is pushed, leaf is passed to onLeaf
+func (I *jsonWalker) walkToken(ch byte, path string, pointer string) error {
+	switch ch {
+	case '{', '[':
+		subPath, subPointer := I.childPath(path, pointer)
+		I.stack = append(I.stack, &jsonFrame{isArray: ch == '[', state: jsonStateStart, path: subPath, pointer: subPointer})
+		return I.write(ch)
+	case '"':
+		raw, err := I.readString()
+		if err != nil {
+			return err
+		}
+		return I.leaf(path, pointer, JSON_LEAF_STRING, raw)
+	}
+	raw, err := I.readLiteral(ch)
+	if err != nil {
+		return err
+	}
+	kind := byte(JSON_LEAF_NUMBER)
+	switch string(raw) {
+	case "true", "false":
+		kind = JSON_LEAF_BOOL
+	case "null":
+		kind = JSON_LEAF_NULL
+	default:
+		if !isJSONNumber(string(raw)) {
+			return fmt.Errorf("%w, literal: %s", errlist.ERR_JSON_INVALID, raw)
+		}
+	}
+	return I.leaf(path, pointer, kind, raw)
+}
+
+// top returns current container, nil if it is top level
+func (I *jsonWalker) top() *jsonFrame {
+	if len(I.stack) == 0 {
+		return nil
+	}
+	return I.stack[len(I.stack)-1]
+}
+
+// childPath returns path and pointer of current child
+func (I *jsonWalker) childPath(path string, pointer string) (string, string) {
+	top := I.top()
+	if top == nil {
+		return strings.ToLower(path), pointer
+	}
+	if top.isArray {
+		if len(top.path) == 0 {
+			return fmt.Sprintf("/[%d]", top.index), fmt.Sprintf("%s/%d", top.pointer, top.index)
+		}
+		return fmt.Sprintf("%s[%d]", top.path, top.index), fmt.Sprintf("%s/%d", top.pointer, top.index)
+	}
+	return strings.ToLower(top.path + "/" + top.key), top.pointer + "/" + detector.EscapeJSONPointer(top.key)
+}
+
+// leaf calls onLeaf and writes returned literal
+func (I *jsonWalker) leaf(path string, pointer string, kind byte, raw []byte) error {
+	leafPath, leafPointer := I.childPath(path, pointer)
+	out, err := I.onLeaf(leafPath, leafPointer, kind, raw)
+	if err != nil {
+		return err
+	}
+	_, err = I.writer.Write(out)
+	return err
+}
+
+// readString reads a string token, the first quote has been read
+func (I *jsonWalker) readString() ([]byte, error) {
+	I.token = append(I.token[:0], '"')
+	escaped := false
+	for {
+		ch, err := I.reader.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				return nil, io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		I.token = append(I.token, ch)
+		if escaped {
+			escaped = false
+		} else if ch == '\\' {
+			escaped = true
+		} else if ch == '"' {
+			return I.token, nil
+		}
+	}
+}
+
+// readLiteral reads a number, true, false or null token, first is the first byte
+func (I *jsonWalker) readLiteral(first byte) ([]byte, error) {
+	I.token = append(I.token[:0], first)
+	for {
+		ch, err := I.reader.ReadByte()
+		if err == io.EOF {
+			return I.token, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !(ch >= '0' && ch <= '9') && !(ch >= 'a' && ch <= 'z') && ch != '-' && ch != '+' && ch != '.' && ch != 'E' {
+			if err := I.reader.UnreadByte(); err != nil {
+				return nil, err
+			}
+			return I.token, nil
+		}
+		I.token = append(I.token, ch)
+	}
+}
+
+// write writes one byte into writer
+func (I *jsonWalker) write(ch byte) error {
+	return I.writer.WriteByte(ch)
+}
+
+// rewriteJSON replaces leaves of jsonText by kvMap which stores JSON Pointer and masked value,
+// other bytes such as key order, whitespace and number literals are not changed
+func (I *Engine) rewriteJSON(jsonText []byte, path string, pointer string, kvMap map[string]string) ([]byte, error) {
+	out := bytes.NewBuffer(make([]byte, 0, len(jsonText)+DEF_RESULT_SIZE))
+	walker := newJSONWalker(bytes.NewReader(jsonText), out, func(leafPath string, leafPointer string, kind byte, raw []byte) ([]byte, error) {
+		return I.rewriteJSONLeaf(leafPath, leafPointer, kind, raw, kvMap)
+	})
+	for {
+		if err := walker.walkValue(path, pointer); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+	}
+	return out.Bytes(), nil
+}
+
+// rewriteJSONLeaf returns masked literal of a leaf, raw literal is returned if it is not masked
+func (I *Engine) rewriteJSONLeaf(path string, pointer string, kind byte, raw []byte, kvMap map[string]string) ([]byte, error) {
+	switch kind {
+	case JSON_LEAF_STRING:
+		var val string
+		if err := json.Unmarshal(raw, &val); err != nil {
+			return nil, err
+		}
+		if I.maybeJSON(val) { // nested json in string
+			var subObj interface{}
+			if err := I.unmarshalJSON([]byte(val), &subObj); err == nil {
+				out, err := I.rewriteJSON([]byte(val), path, pointer, kvMap)
+				if err != nil || string(out) == val {
+					return raw, err
+				}
+				return encodeJSONString(string(out))
+			}
+			return raw, nil
+		}
+		if mask, ok := kvMap[pointer]; ok && mask != val {
+			return encodeJSONString(mask)
+		}
+	case JSON_LEAF_NUMBER, JSON_LEAF_BOOL:
+		val := string(raw)
+		if mask, ok := kvMap[pointer]; ok && mask != val {
+			var orig interface{} = json.Number(val)
+			if kind == JSON_LEAF_BOOL {
+				orig = val == "true"
+			}
+			switch ret := I.maskJSONLiteral(orig, mask).(type) {
+			case json.Number:
+				return []byte(ret), nil
+			default:
+				return encodeJSONString(mask)
+			}
+		}
+	}
+	return raw, nil
+}
+
+// encodeJSONString encodes string as JSON string literal without HTML escaping, so that <PHONE> is kept
+func encodeJSONString(in string) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, len(in)+2))
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(in); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
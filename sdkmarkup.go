@@ -0,0 +1,512 @@
+// Package dlp sdkmarkup.go implements DetectXML, DeidentifyXML, DetectHTML and DeidentifyHTML
+// text and attribute values are located by a light markup scanner, so that markup bytes are kept as they are
+package dlp
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/bytedance/godlp/detector"
+	"github.com/bytedance/godlp/dlpheader"
+	"github.com/bytedance/godlp/errlist"
+)
+
+// markupSegment is a text or attribute value in markup, input[start:end] is the raw bytes
+type markupSegment struct {
+	key      string // XPath-like path, such as /Envelope/Body/user[2]/phone or /html/body/a/@href, names of XML keep their case
+	matchKey string // lower case key for KV rules, whose last name is local name, such as /envelope/body/a/href
+	value    string // value with entities decoded
+	start    int
+	end      int
+	quote    byte // quote of attribute value, 0 for text, [ for CDATA, u for unquoted value in HTML
+}
+
+// markupFrame is an open element on the stack of markupScanner
+type markupFrame struct {
+	name     string
+	path     string
+	children map[string]int // name => count of child elements
+}
+
+// markupScanner scans XML or HTML text into segments
+type markupScanner struct {
+	input  string
+	isHTML bool
+	pos    int
+	stack  []*markupFrame
+	segs   []*markupSegment
+	roots  map[string]int // name => count of top level elements
+}
+
+// void elements of HTML, which have no end tag
+var htmlVoidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+	"input": true, "link": true, "meta": true, "param": true, "source": true, "track": true, "wbr": true,
+}
+
+// raw text elements of HTML, content is copied without detection
+var htmlRawTextElements = map[string]bool{
+	"script": true, "style": true,
+}
+
+// public func
+
+// DetectXML detects text and attribute values of XML, element and attribute names are keys for KV rules
+// DetectResult.Key is XPath-like path with names in original case, such as /Envelope/Body/user/phone or /Envelope/Body/user/@id
+// ByteStart and ByteEnd of results are offsets in the text or attribute value with entities decoded, not in xmlText
+// 对XML进行敏感信息识别，元素名和属性名作为KV规则的key，结果的Key为类似XPath的路径
+func (I *Engine) DetectXML(xmlText string) (retResults []*dlpheader.DetectResult, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	_, retResults, retErr = I.markupImpl(xmlText, false, false)
+	return
+}
+
+// DeidentifyXML detects XML firstly, then returns masked XML, only text and attribute values are rewritten
+// 对XML先识别，然后按规则进行打码，只改写文本和属性值，标签保持不变
+func (I *Engine) DeidentifyXML(xmlText string) (outStr string, retResults []*dlpheader.DetectResult, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return xmlText, nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	outStr, retResults, retErr = I.markupImpl(xmlText, false, true)
+	return
+}
+
+// DetectHTML detects text and attribute values of HTML, content of script and style is skipped
+// names in DetectResult.Key are lower case, ByteStart and ByteEnd are offsets in the text or attribute value like DetectXML
+// 对HTML进行敏感信息识别，script和style的内容不识别
+func (I *Engine) DetectHTML(htmlText string) (retResults []*dlpheader.DetectResult, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	_, retResults, retErr = I.markupImpl(htmlText, true, false)
+	return
+}
+
+// DeidentifyHTML detects HTML firstly, then returns masked HTML, only text and attribute values are rewritten
+// 对HTML先识别，然后按规则进行打码，只改写文本和属性值，标签保持不变
+func (I *Engine) DeidentifyHTML(htmlText string) (outStr string, retResults []*dlpheader.DetectResult, retErr error) {
+	defer I.recoveryImpl()
+
+	if !I.hasConfiged() { // not configed
+		panic(errlist.ERR_HAS_NOT_CONFIGED)
+	}
+	if I.hasClosed() {
+		return htmlText, nil, errlist.ERR_PROCESS_AFTER_CLOSE
+	}
+	outStr, retResults, retErr = I.markupImpl(htmlText, true, true)
+	return
+}
+
+// private func
+
+// markupImpl detects each segment as a KV item, masked values are escaped and written back in deidentify mode
+func (I *Engine) markupImpl(inputText string, isHTML bool, isDeidentify bool) (string, []*dlpheader.DetectResult, error) {
+	scanner := &markupScanner{
+		input:  inputText,
+		isHTML: isHTML,
+		stack:  make([]*markupFrame, 0, DEF_RESULT_SIZE),
+		segs:   make([]*markupSegment, 0, DEF_RESULT_SIZE),
+		roots:  make(map[string]int),
+	}
+	segs, err := scanner.scan()
+	if err != nil {
+		return inputText, nil, err
+	}
+	results := make([]*dlpheader.DetectResult, 0, DEF_RESULT_SIZE)
+	var out strings.Builder
+	if isDeidentify {
+		out.Grow(len(inputText))
+	}
+	last := 0
+	for _, seg := range segs {
+		segResults, err := I.detectListImpl([]*detector.KVItem{{Key: seg.matchKey, Value: seg.value}})
+		if err != nil {
+			return inputText, results, err
+		}
+		for _, res := range segResults {
+			res.Key = seg.key
+		}
+		results = append(results, segResults...)
+		if !isDeidentify || len(segResults) == 0 {
+			continue
+		}
+		masked, err := I.deidentifyByResult(seg.value, I.resultsForDeidentify(segResults))
+		if err != nil {
+			return inputText, results, err
+		}
+		out.WriteString(inputText[last:seg.start])
+		if seg.quote == 'u' { // unquoted value is quoted, because masked value may contain space
+			out.WriteString("\"" + escapeMarkup(masked, '"') + "\"")
+		} else {
+			out.WriteString(escapeMarkup(masked, seg.quote))
+		}
+		last = seg.end
+	}
+	if !isDeidentify {
+		return inputText, results, nil
+	}
+	out.WriteString(inputText[last:])
+	return out.String(), results, nil
+}
+
+// escapeMarkup escapes & < > and quote of attribute value, content of CDATA is not escaped
+func escapeMarkup(in string, quote byte) string {
+	if quote == '[' {
+		return strings.Replace(in, "]]>", "]] >", -1)
+	}
+	if strings.IndexAny(in, "&<>\"'") == -1 {
+		return in
+	}
+	var out strings.Builder
+	for i := 0; i < len(in); i++ {
+		switch ch := in[i]; {
+		case ch == '&':
+			out.WriteString("&amp;")
+		case ch == '<':
+			out.WriteString("&lt;")
+		case ch == '>':
+			out.WriteString("&gt;")
+		case ch == '"' && quote == '"':
+			out.WriteString("&quot;")
+		case ch == '\'' && quote == '\'':
+			out.WriteString("&#39;")
+		default:
+			out.WriteByte(ch)
+		}
+	}
+	return out.String()
+}
+
+// scan returns segments in input order
+func (I *markupScanner) scan() ([]*markupSegment, error) {
+	sz := len(I.input)
+	for I.pos < sz {
+		if I.input[I.pos] != '<' {
+			I.scanText()
+			continue
+		}
+		rest := I.input[I.pos:]
+		var err error
+		switch {
+		case strings.HasPrefix(rest, "<!--"):
+			err = I.skipTo("-->")
+		case strings.HasPrefix(rest, "<![CDATA["):
+			err = I.scanCDATA()
+		case strings.HasPrefix(rest, "<!"):
+			err = I.skipDeclaration()
+		case strings.HasPrefix(rest, "<?"):
+			err = I.skipTo("?>")
+		case strings.HasPrefix(rest, "</"):
+			err = I.scanEndTag()
+		case len(rest) > 1 && isNameStart(rest[1]):
+			err = I.scanStartTag()
+		default: // '<' in text, only allowed in HTML
+			if !I.isHTML {
+				err = fmt.Errorf("%w, offset: %d", errlist.ERR_MARKUP_INVALID, I.pos)
+			}
+			I.scanText()
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !I.isHTML && len(I.stack) != 0 {
+		return nil, fmt.Errorf("%w, element is not closed: %s", errlist.ERR_MARKUP_INVALID, I.stack[len(I.stack)-1].name)
+	}
+	return I.segs, nil
+}
+
+// scanText scans text until next '<', surrounding whitespace is not included in segment
+func (I *markupScanner) scanText() {
+	st := I.pos
+	ed := strings.IndexByte(I.input[st+1:], '<')
+	if ed == -1 {
+		ed = len(I.input)
+	} else {
+		ed += st + 1
+	}
+	I.pos = ed
+	for st < ed && isMarkupSpace(I.input[st]) {
+		st++
+	}
+	for ed > st && isMarkupSpace(I.input[ed-1]) {
+		ed--
+	}
+	if st < ed {
+		I.addSegment(I.currPath(), st, ed, html.UnescapeString(I.input[st:ed]), 0)
+	}
+}
+
+// scanCDATA scans <![CDATA[...]]>, content is text without entities
+func (I *markupScanner) scanCDATA() error {
+	st := I.pos + len("<![CDATA[")
+	ed := strings.Index(I.input[st:], "]]>")
+	if ed == -1 {
+		return fmt.Errorf("%w, CDATA is not closed, offset: %d", errlist.ERR_MARKUP_INVALID, I.pos)
+	}
+	ed += st
+	I.pos = ed + len("]]>")
+	if st < ed {
+		I.addSegment(I.currPath(), st, ed, I.input[st:ed], '[')
+	}
+	return nil
+}
+
+// skipDeclaration skips <!DOCTYPE ...>, brackets of internal subset are matched
+func (I *markupScanner) skipDeclaration() error {
+	depth := 0
+	for i := I.pos + 2; i < len(I.input); i++ {
+		switch I.input[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '>':
+			if depth <= 0 {
+				I.pos = i + 1
+				return nil
+			}
+		}
+	}
+	return I.notClosed()
+}
+
+// skipTo skips bytes until end, end is skipped too
+func (I *markupScanner) skipTo(end string) error {
+	pos := strings.Index(I.input[I.pos:], end)
+	if pos == -1 {
+		return I.notClosed()
+	}
+	I.pos += pos + len(end)
+	return nil
+}
+
+// scanEndTag scans </name>, elements which are not closed in HTML are closed too
+func (I *markupScanner) scanEndTag() error {
+	st := I.pos + 2
+	ed := strings.IndexByte(I.input[st:], '>')
+	if ed == -1 {
+		return I.notClosed()
+	}
+	ed += st
+	I.pos = ed + 1
+	name := I.normalizeName(strings.TrimSpace(I.input[st:ed]))
+	for i := len(I.stack) - 1; i >= 0; i-- {
+		if I.stack[i].name == name {
+			I.stack = I.stack[:i]
+			return nil
+		}
+		if !I.isHTML {
+			break
+		}
+	}
+	if I.isHTML { // stray end tag is ignored
+		return nil
+	}
+	return fmt.Errorf("%w, unexpected end tag: %s, offset: %d", errlist.ERR_MARKUP_INVALID, name, st-2)
+}
+
+// scanStartTag scans <name attr="value" ...> or <name ... />
+func (I *markupScanner) scanStartTag() error {
+	i := I.pos + 1
+	sz := len(I.input)
+	nameSt := i
+	for i < sz && !isMarkupSpace(I.input[i]) && I.input[i] != '>' && I.input[i] != '/' {
+		i++
+	}
+	name := I.normalizeName(I.input[nameSt:i])
+	path := I.childPath(name)
+	selfClosed := false
+	for {
+		for i < sz && isMarkupSpace(I.input[i]) {
+			i++
+		}
+		if i >= sz {
+			return I.notClosed()
+		}
+		if I.input[i] == '>' {
+			i++
+			break
+		}
+		if I.input[i] == '/' {
+			if i+1 < sz && I.input[i+1] == '>' {
+				selfClosed = true
+				i += 2
+				break
+			}
+			i++
+			continue
+		}
+		// attribute name
+		attrSt := i
+		for i < sz && !isMarkupSpace(I.input[i]) && I.input[i] != '=' && I.input[i] != '>' && I.input[i] != '/' {
+			i++
+		}
+		attrName := I.normalizeName(I.input[attrSt:i])
+		for i < sz && isMarkupSpace(I.input[i]) {
+			i++
+		}
+		if i >= sz || I.input[i] != '=' { // attribute without value
+			if !I.isHTML {
+				return fmt.Errorf("%w, attribute without value: %s, offset: %d", errlist.ERR_MARKUP_INVALID, attrName, attrSt)
+			}
+			continue
+		}
+		i++
+		for i < sz && isMarkupSpace(I.input[i]) {
+			i++
+		}
+		if i >= sz {
+			return I.notClosed()
+		}
+		quote := I.input[i]
+		valSt, valEd := 0, 0
+		if quote == '"' || quote == '\'' {
+			valSt = i + 1
+			pos := strings.IndexByte(I.input[valSt:], quote)
+			if pos == -1 {
+				return I.notClosed()
+			}
+			valEd = valSt + pos
+			i = valEd + 1
+		} else if I.isHTML { // unquoted value
+			quote = 'u'
+			valSt = i
+			for i < sz && !isMarkupSpace(I.input[i]) && I.input[i] != '>' {
+				i++
+			}
+			valEd = i
+		} else {
+			return fmt.Errorf("%w, attribute value is not quoted: %s, offset: %d", errlist.ERR_MARKUP_INVALID, attrName, attrSt)
+		}
+		// namespace declaration is not data
+		if valSt < valEd && attrName != "xmlns" && !strings.HasPrefix(attrName, "xmlns:") {
+			I.addSegment(path+"/@"+attrName, valSt, valEd, html.UnescapeString(I.input[valSt:valEd]), quote)
+		}
+	}
+	I.pos = i
+	if selfClosed || (I.isHTML && htmlVoidElements[name]) {
+		return nil
+	}
+	if I.isHTML && htmlRawTextElements[name] { // content of script and style is skipped
+		pos := indexFoldASCII(I.input[I.pos:], "</"+name)
+		if pos == -1 {
+			I.pos = sz
+			return nil
+		}
+		I.pos += pos
+	}
+	I.stack = append(I.stack, &markupFrame{name: name, path: path})
+	return nil
+}
+
+// childPath returns path of child element, index starts from 2 for repeated siblings like XPath
+func (I *markupScanner) childPath(name string) string {
+	parent := ""
+	counter := I.roots
+	if len(I.stack) != 0 {
+		top := I.stack[len(I.stack)-1]
+		if top.children == nil {
+			top.children = make(map[string]int)
+		}
+		parent = top.path
+		counter = top.children
+	}
+	counter[name]++
+	if cnt := counter[name]; cnt > 1 {
+		return fmt.Sprintf("%s/%s[%d]", parent, name, cnt)
+	}
+	return parent + "/" + name
+}
+
+// currPath returns path of current element, text out of elements uses /
+func (I *markupScanner) currPath() string {
+	if len(I.stack) == 0 {
+		return "/"
+	}
+	return I.stack[len(I.stack)-1].path
+}
+
+// addSegment appends a segment
+func (I *markupScanner) addSegment(key string, start int, end int, value string, quote byte) {
+	I.segs = append(I.segs, &markupSegment{key: key, matchKey: strings.ToLower(localNameKey(key)), value: value, start: start, end: end, quote: quote})
+}
+
+// localNameKey trims @ of attribute and namespace prefix of the last name in path, such as /a/@soap:id is /a/id
+// other parts of path are kept, index such as [2] is kept too
+func localNameKey(path string) string {
+	pos := strings.LastIndexByte(path, '/')
+	name, index := path[pos+1:], ""
+	if strings.HasSuffix(name, "]") {
+		if st := strings.LastIndexByte(name, '['); st != -1 {
+			name, index = name[:st], name[st:]
+		}
+	}
+	name = strings.TrimPrefix(name, "@")
+	if st := strings.LastIndexByte(name, ':'); st != -1 && st+1 < len(name) {
+		name = name[st+1:]
+	}
+	return path[:pos+1] + name + index
+}
+
+// normalizeName returns lower case name for HTML, names of XML are case sensitive, so they are kept
+func (I *markupScanner) normalizeName(name string) string {
+	if I.isHTML {
+		return strings.ToLower(name)
+	}
+	return name
+}
+
+// indexFoldASCII returns index of lower case ASCII sub in s, ASCII letters of s are compared case-insensitively
+// s is not lowercased as a whole, because lowercase of some non-ASCII chars has different length, which breaks the offset
+func indexFoldASCII(s string, sub string) int {
+	for i := 0; i+len(sub) <= len(s); i++ {
+		j := 0
+		for ; j < len(sub); j++ {
+			ch := s[i+j]
+			if 'A' <= ch && ch <= 'Z' {
+				ch += 'a' - 'A'
+			}
+			if ch != sub[j] {
+				break
+			}
+		}
+		if j == len(sub) {
+			return i
+		}
+	}
+	return -1
+}
+
+// notClosed returns error of unclosed markup
+func (I *markupScanner) notClosed() error {
+	return fmt.Errorf("%w, markup is not closed, offset: %d", errlist.ERR_MARKUP_INVALID, I.pos)
+}
+
+// isNameStart checks whether ch can start a tag name
+func isNameStart(ch byte) bool {
+	return (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || ch == '_' || ch == ':' || ch >= 0x80
+}
+
+// isMarkupSpace checks whitespace of markup
+func isMarkupSpace(ch byte) bool {
+	return ch == ' ' || ch == '\t' || ch == '\r' || ch == '\n' || ch == '\f'
+}
@@ -64,6 +64,7 @@ func (I *Engine) RegisterMasker(maskName string, maskFunc func(string) (string,
 	} else {
 		if worker, err := I.NewDIYMaskWorker(maskName, maskFunc); err == nil {
 			I.maskerMap[maskName] = worker
+			I.changeRuleset()
 			return nil
 		} else {
 			return err
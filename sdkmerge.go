@@ -0,0 +1,236 @@
+// Package dlp sdkmerge.go implements overlap resolution policies used by mergeResults
+package dlp
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/bytedance/godlp/dlpheader"
+)
+
+// const var for Global.OverlapPolicy
+const (
+	OVERLAP_POLICY_DEFAULT  = "DEFAULT"  // contained result is dropped, greater RuleID wins if positions are same
+	OVERLAP_POLICY_LEVEL    = "LEVEL"    // highest Level wins
+	OVERLAP_POLICY_SCORE    = "SCORE"    // highest Score wins
+	OVERLAP_POLICY_LONGEST  = "LONGEST"  // longest span wins
+	OVERLAP_POLICY_UNION    = "UNION"    // overlapped spans are combined, highest Level provides rule info
+	OVERLAP_POLICY_KEEP_ALL = "KEEP_ALL" // all results are kept for reporting
+)
+
+// private func
+
+// getOverlapPolicy returns Global.OverlapPolicy, DEFAULT if it is empty
+func (I *Engine) getOverlapPolicy() string {
+	if I.confObj == nil || len(I.confObj.Global.OverlapPolicy) == 0 {
+		return OVERLAP_POLICY_DEFAULT
+	}
+	return strings.ToUpper(I.confObj.Global.OverlapPolicy)
+}
+
+// keptSet marks kept results of one mergeKey, list is ordered by position, tree is a Fenwick tree of marks over list
+type keptSet struct {
+	list []*dlpheader.DetectResult
+	tree []int
+}
+
+// resolveByPriority keeps the result with higher priority if results overlap, input must be sorted by position
+// results are visited by priority, a result is kept if it does not overlap kept results of the same mergeKey
+// kept results do not overlap, so only the last kept one starting before res ends may overlap with it, which is found in O(log n)
+func (I *Engine) resolveByPriority(sorted []*dlpheader.DetectResult, policy string) []*dlpheader.DetectResult {
+	setMap := make(map[string]*keptSet)
+	setList := make([]*keptSet, len(sorted)) // set of sorted[i]
+	rank := make([]int, len(sorted))         // index of sorted[i] in list of its set
+	for i, res := range sorted {
+		key := mergeKey(res)
+		set, ok := setMap[key]
+		if !ok {
+			set = new(keptSet)
+			setMap[key] = set
+		}
+		setList[i] = set
+		rank[i] = len(set.list)
+		set.list = append(set.list, res)
+	}
+	for _, set := range setMap {
+		set.tree = make([]int, len(set.list)+1)
+	}
+	order := make([]int, len(sorted))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(x, y int) bool {
+		return higherPriority(sorted[order[x]], sorted[order[y]], policy)
+	})
+	ret := make([]*dlpheader.DetectResult, 0, len(sorted))
+	for _, idx := range order {
+		res := sorted[idx]
+		set := setList[idx]
+		end := sort.Search(len(set.list), func(i int) bool {
+			return set.list[i].ByteStart >= res.ByteEnd
+		})
+		if last := set.lastKept(end); last != -1 && set.list[last].ByteEnd > res.ByteStart {
+			continue
+		}
+		set.keep(rank[idx])
+		ret = append(ret, res)
+	}
+	sort.Sort(ResultList(ret))
+	return ret
+}
+
+// keep marks list[i] as kept
+func (I *keptSet) keep(i int) {
+	for i++; i < len(I.tree); i += i & -i {
+		I.tree[i]++
+	}
+}
+
+// lastKept returns index of the last kept result in list[:end], -1 if there is none
+func (I *keptSet) lastKept(end int) int {
+	cnt := 0
+	for i := end; i > 0; i -= i & -i {
+		cnt += I.tree[i]
+	}
+	if cnt == 0 {
+		return -1
+	}
+	// find the cnt-th kept result by descending the tree
+	pos := 0
+	step := 1
+	for step*2 < len(I.tree) {
+		step *= 2
+	}
+	for ; step > 0; step /= 2 {
+		if next := pos + step; next < len(I.tree) && I.tree[next] < cnt {
+			pos = next
+			cnt -= I.tree[next]
+		}
+	}
+	return pos // tree is 1-based, so pos is the 0-based index of the cnt-th kept result
+}
+
+// resolveByUnion combines overlapped results into one result, input must be sorted by position
+// if byKey is false, results with different Key are also combined, because they are in the same text
+// combined result is a new object, so that input results will not be modified
+func (I *Engine) resolveByUnion(sorted []*dlpheader.DetectResult, byKey bool) []*dlpheader.DetectResult {
+	ret := make([]*dlpheader.DetectResult, 0, len(sorted))
+	lastMap := make(map[string]int) // mergeKey => index of last result in ret
+	for _, res := range sorted {
+		key := ""
+		if byKey {
+			key = mergeKey(res)
+		}
+		if idx, ok := lastMap[key]; ok && res.ByteStart < ret[idx].ByteEnd {
+			ret[idx] = unionResult(ret[idx], res)
+			continue
+		}
+		lastMap[key] = len(ret)
+		ret = append(ret, res)
+	}
+	sort.Sort(ResultList(ret))
+	return ret
+}
+
+// resultsForDeidentify makes sure that results of one text used by deidentifyByResult are not overlapped
+// overlapped results are combined then masked again, so that no sensitive byte is left
+// reported results are not modified
+func (I *Engine) resultsForDeidentify(results []*dlpheader.DetectResult) []*dlpheader.DetectResult {
+	sorted := make([]*dlpheader.DetectResult, len(results))
+	copy(sorted, results)
+	sort.Sort(ResultList(sorted))
+	hasOverlap := false
+	maxEnd := 0
+	for _, res := range sorted {
+		if res.ByteStart < maxEnd {
+			hasOverlap = true
+			break
+		}
+		if res.ByteEnd > maxEnd {
+			maxEnd = res.ByteEnd
+		}
+	}
+	if !hasOverlap {
+		return sorted
+	}
+	origMap := make(map[*dlpheader.DetectResult]bool, len(results))
+	for _, res := range results {
+		origMap[res] = true
+	}
+	ret := I.resolveByUnion(sorted, false)
+	for _, res := range ret {
+		if !origMap[res] { // combined result
+			I.maskResults([]*dlpheader.DetectResult{res})
+		}
+	}
+	return ret
+}
+
+// unionResult returns a new result which covers a and b, a.ByteStart <= b.ByteStart
+func unionResult(a *dlpheader.DetectResult, b *dlpheader.DetectResult) *dlpheader.DetectResult {
+	winner := a
+	if higherPriority(b, a, OVERLAP_POLICY_LEVEL) {
+		winner = b
+	}
+	merged := *winner
+	merged.ByteStart = a.ByteStart
+	merged.ByteEnd = a.ByteEnd
+	merged.Text = a.Text
+	merged.MaskText = ""
+	if b.ByteEnd > a.ByteEnd {
+		// Text is input[ByteStart:ByteEnd], so the tail of b.Text is appended
+		if cut := a.ByteEnd - b.ByteStart; cut >= 0 && cut <= len(b.Text) {
+			merged.Text += b.Text[cut:]
+		}
+		merged.ByteEnd = b.ByteEnd
+	}
+	return &merged
+}
+
+// isOverlapped checks whether a and b overlap with the same mergeKey
+func isOverlapped(a *dlpheader.DetectResult, b *dlpheader.DetectResult) bool {
+	return mergeKey(a) == mergeKey(b) && a.ByteStart < b.ByteEnd && b.ByteStart < a.ByteEnd
+}
+
+// mergeKey returns the text which result is in, JSONPointer is used for JSON,
+// because Key of JSON is lower case path, which is same for keys differing in case
+func mergeKey(res *dlpheader.DetectResult) string {
+	if len(res.JSONPointer) != 0 {
+		return res.JSONPointer
+	}
+	return res.Key
+}
+
+// higherPriority checks whether a has higher priority than b in policy
+// ties are broken by span length, Level, then greater RuleID
+func higherPriority(a *dlpheader.DetectResult, b *dlpheader.DetectResult, policy string) bool {
+	lenA := a.ByteEnd - a.ByteStart
+	lenB := b.ByteEnd - b.ByteStart
+	levelA := levelValue(a.Level)
+	levelB := levelValue(b.Level)
+	switch policy {
+	case OVERLAP_POLICY_LEVEL:
+		if levelA != levelB {
+			return levelA > levelB
+		}
+	case OVERLAP_POLICY_SCORE:
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+	}
+	if lenA != lenB {
+		return lenA > lenB
+	}
+	if levelA != levelB {
+		return levelA > levelB
+	}
+	return a.RuleID > b.RuleID
+}
+
+// levelValue converts Level L1 ~ L4 into 1 ~ 4, 0 if level is unknown
+func levelValue(level string) int {
+	if len(level) == 2 && (level[0] == 'L' || level[0] == 'l') && level[1] >= '0' && level[1] <= '9' {
+		return int(level[1] - '0')
+	}
+	return 0
+}
@@ -0,0 +1,141 @@
+// Package dlp sdknormalize.go implements optional preprocessing in detectPre, see Global.Preprocess
+// invisible chars are stripped and confusable chars are folded into ASCII, offset map keeps original positions
+package dlp
+
+import (
+	"unicode/utf8"
+
+	"github.com/bytedance/godlp/dlpheader"
+)
+
+// names of Global.Preprocess
+const (
+	PREPROCESS_INVISIBLE  = "INVISIBLE"  // strip zero-width chars, soft hyphen and bidi controls
+	PREPROCESS_CONFUSABLE = "CONFUSABLE" // fold fullwidth ASCII, Cyrillic and Greek look-alike letters into ASCII
+)
+
+// confusableMap maps look-alike letters into ASCII, fullwidth ASCII is folded by offset
+var confusableMap = map[rune]rune{
+	// Cyrillic
+	'а': 'a', 'е': 'e', 'о': 'o', 'р': 'p', 'с': 'c', 'у': 'y', 'х': 'x', 'і': 'i', 'ј': 'j', 'ѕ': 's', 'ԁ': 'd', 'ԛ': 'q', 'ԝ': 'w', 'һ': 'h',
+	'А': 'A', 'В': 'B', 'Е': 'E', 'К': 'K', 'М': 'M', 'Н': 'H', 'О': 'O', 'Р': 'P', 'С': 'C', 'Т': 'T', 'Х': 'X', 'І': 'I', 'Ј': 'J', 'Ѕ': 'S', 'Ү': 'Y',
+	// Greek
+	'Α': 'A', 'Β': 'B', 'Ε': 'E', 'Ζ': 'Z', 'Η': 'H', 'Ι': 'I', 'Κ': 'K', 'Μ': 'M', 'Ν': 'N', 'Ο': 'O', 'Ρ': 'P', 'Τ': 'T', 'Υ': 'Y', 'Χ': 'X',
+	'ο': 'o', 'ν': 'v', 'ρ': 'p', 'ι': 'i',
+	// punctuation which is used in email, URL and number
+	'‐': '-', '‑': '-', '‒': '-', '–': '-', '−': '-', '․': '.',
+}
+
+// private func
+
+// loadPreprocess reads Global.Preprocess
+func (I *Engine) loadPreprocess() {
+	I.stripInvisible, I.foldConfusable = false, false
+	for _, name := range I.confObj.Global.Preprocess {
+		switch name {
+		case PREPROCESS_INVISIBLE:
+			I.stripInvisible = true
+		case PREPROCESS_CONFUSABLE:
+			I.foldConfusable = true
+		}
+	}
+}
+
+// detectPreWithOffsets works like detectPre, offsets is nil if line is not changed by Global.Preprocess,
+// otherwise offsets[i] is the offset in line of the char which has out[i], len(offsets) is len(out)+1
+func (I *Engine) detectPreWithOffsets(line []byte) ([]byte, []int) {
+	line = I.unquoteEscapeChar(line)
+	line = I.replaceWideChar(line)
+	if !I.stripInvisible && !I.foldConfusable {
+		return line, nil
+	}
+	return I.normalizeLine(line)
+}
+
+// normalizeLine strips invisible chars and folds confusable chars, line is not modified
+func (I *Engine) normalizeLine(line []byte) ([]byte, []int) {
+	i := 0
+	for i < len(line) && line[i] < utf8.RuneSelf { // chars to be processed are not ASCII
+		i++
+	}
+	if i == len(line) {
+		return line, nil
+	}
+	out := make([]byte, i, len(line))
+	copy(out, line[:i])
+	offsets := make([]int, i, len(line)+1)
+	for k := range offsets {
+		offsets[k] = k
+	}
+	changed := false
+	for i < len(line) {
+		r, width := utf8.DecodeRune(line[i:])
+		switch {
+		case I.stripInvisible && isInvisibleChar(r):
+			changed = true
+		case I.foldConfusable && foldConfusable(r) != r:
+			out = append(out, byte(foldConfusable(r)))
+			offsets = append(offsets, i)
+			changed = true
+		default:
+			out = append(out, line[i:i+width]...)
+			for k := 0; k < width; k++ {
+				offsets = append(offsets, i)
+			}
+		}
+		i += width
+	}
+	if !changed {
+		return line, nil
+	}
+	offsets = append(offsets, len(line))
+	return out, offsets
+}
+
+// restoreResultPos converts positions of results in normalized text into positions in line, so that masking covers the original bytes
+// Text of result is still the normalized text, which is masked by rules
+func (I *Engine) restoreResultPos(results []*dlpheader.DetectResult, line []byte, offsets []int) {
+	if offsets == nil {
+		return
+	}
+	for _, res := range results {
+		st, ed := res.ByteStart, res.ByteEnd
+		if st < 0 || ed >= len(offsets) || st >= ed {
+			continue
+		}
+		// end is the end of the last char, so that stripped chars after the result are not covered
+		origSt := offsets[st]
+		origEd := offsets[ed-1]
+		_, width := utf8.DecodeRune(line[origEd:])
+		origEd += width
+		res.ByteStart, res.ByteEnd = origSt, origEd
+	}
+}
+
+// isInvisibleChar checks zero-width chars, soft hyphen, bidi controls and variation selectors
+func isInvisibleChar(r rune) bool {
+	switch {
+	case r == 0x00AD, r == 0x034F, r == 0x061C, r == 0x180E, r == 0xFEFF:
+		return true
+	case 0x200B <= r && r <= 0x200F: // zero-width space, joiners, LRM, RLM
+		return true
+	case 0x202A <= r && r <= 0x202E: // bidi embedding and override
+		return true
+	case 0x2060 <= r && r <= 0x2069: // word joiner, invisible operators, bidi isolates
+		return true
+	case 0xFE00 <= r && r <= 0xFE0F: // variation selectors
+		return true
+	}
+	return false
+}
+
+// foldConfusable returns the ASCII char which r looks like, r itself if there is none
+func foldConfusable(r rune) rune {
+	if 0xFF01 <= r && r <= 0xFF5E { // fullwidth ASCII
+		return r - 0xFF01 + '!'
+	}
+	if c, ok := confusableMap[r]; ok {
+		return c
+	}
+	return r
+}